@@ -2,12 +2,18 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/paschi/discord-date-decider/internal/discord"
+	"github.com/paschi/discord-date-decider/internal/event"
 	"github.com/paschi/discord-date-decider/internal/message"
 	"github.com/paschi/discord-date-decider/internal/poll"
 
@@ -17,64 +23,215 @@ import (
 )
 
 const (
+	defaultMonthOffset      = 1
 	defaultLocale           = "en_US"
 	defaultPollTitle        = "Poll for %s %d"
 	defaultStartPollMessage = "@here :wave: Hey! I just posted a new poll for %s :calendar:. Check it out! :eyes:"
-	defaultEndPollMessage   = "@here We have a winner :trophy:! The next event happens on <t:%d:F> :calendar:. See you then!"
+	defaultRangePollTitle   = "Poll for %s - %s"
+	defaultRangePollMessage = "@here :wave: Hey! I just posted a new poll for %s - %s :calendar:. Check it out! :eyes:"
+	defaultEndPollMessage   = "@here " + defaultWinnerMessage
+	defaultWinnerMessage    = "We have a winner :trophy:! The next event happens on <t:%d:F> :calendar:. See you then!"
+	defaultOtherVoters      = "-# %s, your dates did not win this time, hope you can make it anyway!"
+	defaultTieBreakMessage  = "-# It was a tie with %s, decided by %s."
+	defaultTallyTitle       = "**Results** (%d votes)"
+	defaultRunnerUpMessage  = "-# Runner-up was <t:%d:F> with %s."
+	tallyBarWidth           = 10
+	defaultRunoffPollTitle  = "Runoff: %s"
+	defaultEventMessage     = ":calendar_spiral: Click interested to get reminded: https://discord.com/events/%s/%s"
+	defaultEventDuration    = 3
+	defaultRunoffMessage    = "@here :scales: It's a tie! Please vote again in the runoff poll, it closes <t:%d:R> :ballot_box:."
+	defaultRunoffDuration   = 24
+	defaultReminderMessage  = ":alarm_clock: Reminder! The poll for the next event closes %s, please vote if you have not yet :ballot_box:."
+	defaultReminderDeadline = "soon"
+	defaultNoDateMessage    = "@here :x: We could not find a date this time, not enough votes were cast :calendar:."
+	defaultFollowUpMessage  = "@here :hourglass: Not enough votes yet! Please vote again in the new poll, it closes <t:%d:R> :ballot_box:."
+	defaultFollowUpDuration = 48
+	defaultMinVotes         = 1
+	finalizeAttempts        = 5
+	maxMessageLength        = 2000
+	maxMentionsPerMessage   = 100
+	finalizeDelay           = time.Second
+	noDateActionAnnounce    = "announce"
+	noDateActionFollowUp    = "followUp"
+	holidayModeExclude      = "exclude"
+	holidayModeFlag         = "flag"
+	clientModeRest          = "rest"
+	clientModeGateway       = "gateway"
 )
 
+var defaultWeekdays = []time.Weekday{time.Friday, time.Saturday}
+
 type Bot struct {
 	service discord.Service
+	now     func() time.Time
+	sleep   func(time.Duration)
 }
 
 type PollRequest struct {
-	Action                string `json:"action"`
-	PollChannelID         string `json:"pollChannelId"`
-	AnnouncementChannelID string `json:"announcementChannelId"`
-	TimeZone              string `json:"timeZone"`
-	Locale                string `json:"locale"`
-	Title                 string `json:"title"`
-	Message               string `json:"message"`
-	AdditionalDays        []int  `json:"additionalDays"`
-	ExcludedDays          []int  `json:"excludedDays"`
+	Action                string             `json:"action"`
+	Profile               string             `json:"profile"`
+	PollChannelID         string             `json:"pollChannelId"`
+	AnnouncementChannelID string             `json:"announcementChannelId"`
+	TimeZone              string             `json:"timeZone"`
+	Locale                string             `json:"locale"`
+	Title                 string             `json:"title"`
+	Message               string             `json:"message"`
+	TargetMonth           string             `json:"targetMonth"`
+	MonthOffset           *int               `json:"monthOffset"`
+	StartDate             string             `json:"startDate"`
+	EndDate               string             `json:"endDate"`
+	StartInDays           *int               `json:"startInDays"`
+	DurationDays          int                `json:"durationDays"`
+	VotingDurationHours   int                `json:"votingDurationHours"`
+	ExcludedDates         []string           `json:"excludedDates"`
+	Weekdays              []Weekday          `json:"weekdays"`
+	StartTime             string             `json:"startTime"`
+	WeekdayStartTimes     map[string]string  `json:"weekdayStartTimes"`
+	AdditionalDays        []int              `json:"additionalDays"`
+	ExcludedDays          []int              `json:"excludedDays"`
+	IncludeRules          []string           `json:"includeRules"`
+	ExcludeRules          []string           `json:"excludeRules"`
+	HolidayCalendars      []string           `json:"holidayCalendars"`
+	HolidayCountry        string             `json:"holidayCountry"`
+	HolidaySubdivision    string             `json:"holidaySubdivision"`
+	HolidayMode           string             `json:"holidayMode"`
+	AnswerFormat          string             `json:"answerFormat"`
+	TieBreak              string             `json:"tieBreak"`
+	TieBreakWeekdays      []Weekday          `json:"tieBreakWeekdays"`
+	TieBreakSeed          string             `json:"tieBreakSeed"`
+	ConflictCalendars     []string           `json:"conflictCalendars"`
+	Runoff                bool               `json:"runoff"`
+	RunoffDurationHours   int                `json:"runoffDurationHours"`
+	RunoffMessage         string             `json:"runoffMessage"`
+	MinVotes              *int               `json:"minVotes"` // per date, the winning date needs at least this many votes, regardless of their weight
+	Quorum                int                `json:"quorum"`   // distinct voters across all dates
+	NoDateAction          string             `json:"noDateAction"`
+	NoDateMessage         string             `json:"noDateMessage"`
+	FollowUpDurationHours int                `json:"followUpDurationHours"`
+	FollowUpMessage       string             `json:"followUpMessage"`
+	ResultStrategy        string             `json:"resultStrategy"`
+	ApprovalThreshold     int                `json:"approvalThreshold"`
+	RoleWeights           map[string]float64 `json:"roleWeights"`
+	RequiredMembers       []string           `json:"requiredMembers"`
+	RequiredRole          string             `json:"requiredRole"`
+	EligibleRoles         []string           `json:"eligibleRoles"`
+	GuildID               string             `json:"guildId"`
+	FailureMode           string             `json:"failureMode"`
+	ShowTally             bool               `json:"showTally"`
+	MentionVoters         bool               `json:"mentionVoters"`
+	NotifyOtherVoters     bool               `json:"notifyOtherVoters"`
+	Force                 bool               `json:"force"`
+	ReminderRole          string             `json:"reminderRole"`
+	ReminderMessage       string             `json:"reminderMessage"`
+	CreateEvent           bool               `json:"createEvent"`
+	EventName             string             `json:"eventName"`
+	EventDescription      string             `json:"eventDescription"`
+	EventDurationHours    int                `json:"eventDurationHours"`
+	EventChannelID        string             `json:"eventChannelId"`
+	EventLocation         string             `json:"eventLocation"`
+}
+
+type Outcome string
+
+const (
+	OutcomePollStarted Outcome = "pollStarted"
+	OutcomeWinner      Outcome = "winner"
+	OutcomeRunoff      Outcome = "runoff"
+	OutcomeNoDate      Outcome = "noDate"
+	OutcomeFollowUp    Outcome = "followUp"
+	OutcomeReminded    Outcome = "reminded"
+)
+
+type PollResponse struct {
+	Outcome Outcome    `json:"outcome"`
+	Date    *time.Time `json:"date,omitempty"`
+}
+
+type Weekday string
+
+func (w *Weekday) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*w = Weekday(name)
+		return nil
+	}
+	var number int
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("weekday must be a name or an ISO number: %s", data)
+	}
+	*w = Weekday(strconv.Itoa(number))
+	return nil
 }
 
 func main() {
 	lambda.Start(handleRequest)
 }
 
-func initBot() (*Bot, error) {
+func initBot(ctx context.Context) (*Bot, error) {
 	discordToken := os.Getenv("DISCORD_TOKEN")
 	if discordToken == "" {
 		return nil, fmt.Errorf("could not find discord token in environment")
 	}
-	client, err := discord.NewDefaultClient(discordToken)
+	client, err := newClient(os.Getenv("DISCORD_CLIENT_MODE"), discordToken)
 	if err != nil {
 		return nil, fmt.Errorf("could not create discord client: %w", err)
 	}
-	service := discord.NewDefaultService(client)
+	service := discord.NewDefaultService(discord.NewRetryClient(ctx, client, discord.DefaultRetryPolicy))
 	return NewBot(service), nil
 }
 
-func handleRequest(_ context.Context, request PollRequest) error {
-	bot, err := initBot()
+// newClient defaults to rest only requests, the gateway is only connected in gateway mode
+func newClient(mode string, token string) (discord.Client, error) {
+	var client discord.Client
+	var err error
+	switch mode {
+	case "", clientModeRest:
+		client, err = discord.NewRestClient(token)
+	case clientModeGateway:
+		client, err = discord.NewDefaultClient(token)
+	default:
+		return nil, fmt.Errorf("unknown discord client mode: %s", mode)
+	}
 	if err != nil {
-		return fmt.Errorf("could not initialize bot: %w", err)
+		return nil, err
+	}
+	return client, nil
+}
+
+func handleRequest(ctx context.Context, request PollRequest) (*PollResponse, error) {
+	bot, err := initBot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize bot: %w", err)
 	}
 	switch request.Action {
 	case "startPoll":
-		return bot.StartPoll(request)
+		err = bot.StartPoll(request)
+		if err != nil {
+			return nil, err
+		}
+		return &PollResponse{Outcome: OutcomePollStarted}, nil
 	case "endPoll":
 		return bot.EndPoll(request)
+	case "closePoll":
+		request.Force = true
+		return bot.EndPoll(request)
+	case "remindPoll":
+		err = bot.RemindPoll(request)
+		if err != nil {
+			return nil, err
+		}
+		return &PollResponse{Outcome: OutcomeReminded}, nil
 	default:
 		log.Printf("unknown action: %s", request.Action)
-		return fmt.Errorf("unknown action: %s", request.Action)
+		return nil, fmt.Errorf("unknown action: %s", request.Action)
 	}
 }
 
 func NewBot(service discord.Service) *Bot {
 	return &Bot{
 		service: service,
+		now:     time.Now,
+		sleep:   time.Sleep,
 	}
 }
 
@@ -93,8 +250,6 @@ func (b *Bot) StartPoll(request PollRequest) (err error) {
 			}
 		}
 	}()
-	nextMonth := time.Now().AddDate(0, 1, 0)
-	year, month := nextMonth.Year(), nextMonth.Month()
 	location, err := time.LoadLocation(request.TimeZone)
 	if err != nil {
 		log.Printf("could not load location '%s': %v", request.TimeZone, err)
@@ -106,32 +261,191 @@ func (b *Bot) StartPoll(request PollRequest) (err error) {
 		log.Printf("could not load locale: %s", locale)
 		return
 	}
-	pollTitle := fmt.Sprintf(getOrDefault(request.Title, defaultPollTitle), lctime.Strftime("%B", nextMonth), year)
-	datePoll := poll.NewDatePoll(pollTitle, year, month, []time.Weekday{time.Friday, time.Saturday}, location, request.AdditionalDays, request.ExcludedDays)
-	pollID, err := b.service.SendPoll(request.PollChannelID, datePoll)
+	weekdays, err := getWeekdays(request.Weekdays)
 	if err != nil {
-		log.Printf("service could not send poll to poll channel: %v", err)
+		log.Printf("could not parse weekdays: %v", err)
 		return
 	}
-	log.Printf("service successfully sent poll to poll channel: %s", pollID)
-	err = b.service.PinPoll(request.PollChannelID, pollID)
+	startTimes, err := getStartTimes(request.StartTime, request.WeekdayStartTimes)
 	if err != nil {
-		log.Printf("service could not pin poll to poll channel: %v", err)
+		log.Printf("could not parse start times: %v", err)
 		return
 	}
-	log.Printf("service successfully pinned poll to poll channel")
-	messageText := fmt.Sprintf(getOrDefault(request.Message, defaultStartPollMessage), lctime.Strftime("%B", nextMonth))
-	announcement := message.NewMessage(messageText, true)
-	messageID, err := b.service.SendMessage(request.AnnouncementChannelID, announcement)
+	filter, err := getDateFilter(request.IncludeRules, request.ExcludeRules, request.HolidayCalendars, request.HolidayCountry, request.HolidaySubdivision, request.HolidayMode, location)
 	if err != nil {
-		log.Printf("service could not send message to announcement channel: %v", err)
+		log.Printf("could not create date filter: %v", err)
 		return
 	}
-	log.Printf("service successfully sent message to announcement channel: %s", messageID)
-	return
+	runner, err := newStepRunner(request.FailureMode)
+	if err != nil {
+		log.Printf("invalid failure mode: %v", err)
+		return
+	}
+	answerFormat, err := poll.ResolveAnswerFormat(request.AnswerFormat, locale)
+	if err != nil {
+		log.Printf("could not resolve answer format: %v", err)
+		return
+	}
+	datePoll, messageText, err := b.createDatePoll(request, weekdays, startTimes, filter, location)
+	if err != nil {
+		log.Printf("could not create poll: %v", err)
+		return
+	}
+	now := b.now().In(location)
+	if request.VotingDurationHours < 0 {
+		log.Printf("voting duration must not be negative: %d", request.VotingDurationHours)
+		return fmt.Errorf("voting duration must not be negative: %d", request.VotingDurationHours)
+	}
+	if request.VotingDurationHours > 0 {
+		// short notice polls cannot close on the day before the first date, so they close after the voting duration instead
+		datePoll.CloseAt(getFollowUpExpiry(now, request.VotingDurationHours))
+	}
+	err = datePoll.ValidateExpiry(now)
+	if err != nil {
+		log.Printf("invalid poll expiry, votingDurationHours sets when the poll closes: %v", err)
+		return
+	}
+	datePoll.AnswerFormat = answerFormat
+	err = datePoll.ValidateLabels()
+	if err != nil {
+		log.Printf("could not format poll answers: %v", err)
+		return
+	}
+	datePoll.Profile = request.Profile
+	postedPolls, err := b.service.FindPolls(request.PollChannelID, datePoll.Period, datePoll.Profile)
+	if err != nil {
+		log.Printf("service could not find already posted polls: %v", err)
+		return
+	}
+	pollParts := datePoll.Split()
+	if len(postedPolls) > 0 {
+		log.Printf("resuming poll that was already posted for %s: %+v", datePoll.Period, postedPolls)
+		// parts that are still missing have to join the group of the posted parts
+		for _, pollPart := range pollParts {
+			pollPart.GroupID = postedPolls[0].GroupID
+		}
+	}
+	var pollIDs []string
+	for _, pollPart := range pollParts {
+		pollIDs = append(pollIDs, b.postPollPart(runner, request.PollChannelID, pollPart, postedPolls))
+	}
+	if slices.ContainsFunc(postedPolls, func(postedPoll poll.PostedPoll) bool { return postedPoll.Announced }) {
+		log.Printf("poll was already announced")
+		return runner.err()
+	}
+	b.announceSteps(runner, request.AnnouncementChannelID, []*message.Message{message.NewMessage(messageText, true)})
+	runner.run(step{
+		name: "mark poll as announced",
+		run: func() error {
+			if pollIDs[0] == "" {
+				log.Printf("skipping mark of poll that was not sent")
+				return nil
+			}
+			err := b.service.MarkPollAnnounced(request.PollChannelID, pollIDs[0])
+			if err != nil {
+				log.Printf("service could not mark poll as announced: %v", err)
+				return err
+			}
+			log.Printf("service successfully marked poll as announced")
+			return nil
+		},
+	})
+	return runner.err()
+}
+
+// postPollPart only sends and pins what is missing, so that repeated requests do not post the poll twice
+func (b *Bot) postPollPart(runner *stepRunner, channelID string, pollPart *poll.DatePoll, postedPolls []poll.PostedPoll) string {
+	index := slices.IndexFunc(postedPolls, func(postedPoll poll.PostedPoll) bool { return postedPoll.Part == pollPart.Part })
+	if index >= 0 && postedPolls[index].Pinned {
+		log.Printf("poll was already sent and pinned to poll channel: %s", postedPolls[index].ID)
+		return postedPolls[index].ID
+	}
+	var pollID string
+	if index >= 0 {
+		pollID = postedPolls[index].ID
+		log.Printf("poll was already sent to poll channel: %s", pollID)
+	} else {
+		runner.run(step{
+			name: "send poll",
+			run: func() error {
+				var err error
+				pollID, err = b.service.SendPoll(channelID, pollPart)
+				if err != nil {
+					log.Printf("service could not send poll to poll channel: %v", err)
+					return err
+				}
+				log.Printf("service successfully sent poll to poll channel: %s", pollID)
+				return nil
+			},
+			compensate: func() error {
+				return b.service.DeleteMessage(channelID, pollID)
+			},
+		})
+	}
+	runner.run(step{
+		name: "pin poll",
+		run: func() error {
+			if pollID == "" {
+				log.Printf("skipping pin of poll that was not sent")
+				return nil
+			}
+			err := b.service.PinPoll(channelID, pollID)
+			if err != nil {
+				log.Printf("service could not pin poll to poll channel: %v", err)
+				return err
+			}
+			log.Printf("service successfully pinned poll to poll channel")
+			return nil
+		},
+		compensate: func() error {
+			return b.service.UnpinPoll(channelID, pollID)
+		},
+	})
+	return pollID
 }
 
-func (b *Bot) EndPoll(request PollRequest) (err error) {
+func (b *Bot) createDatePoll(request PollRequest, weekdays []time.Weekday, startTimes poll.StartTimes, filter poll.DateFilter, location *time.Location) (*poll.DatePoll, string, error) {
+	now := b.now().In(location)
+	if !isDateRangeRequest(request) {
+		if len(request.ExcludedDates) > 0 {
+			return nil, "", fmt.Errorf("excluded dates require a date range, use excluded days for monthly polls")
+		}
+		targetMonth, err := getTargetMonth(request.TargetMonth, request.MonthOffset, now)
+		if err != nil {
+			return nil, "", fmt.Errorf("could not determine target month: %w", err)
+		}
+		year, month := targetMonth.Year(), targetMonth.Month()
+		monthName := lctime.Strftime("%B", targetMonth)
+		pollTitle := fmt.Sprintf(getOrDefault(request.Title, defaultPollTitle), monthName, year)
+		messageText := fmt.Sprintf(getOrDefault(request.Message, defaultStartPollMessage), monthName)
+		datePoll := poll.NewDatePoll(pollTitle, year, month, weekdays, startTimes, location, request.AdditionalDays, request.ExcludedDays, filter)
+		datePoll.Period = targetMonth.Format("2006-01")
+		return datePoll, messageText, nil
+	}
+	if request.TargetMonth != "" || request.MonthOffset != nil || len(request.AdditionalDays) > 0 || len(request.ExcludedDays) > 0 {
+		return nil, "", fmt.Errorf("date ranges must not be combined with target month, month offset, additional days or excluded days")
+	}
+	firstDay, lastDay, err := getDateRange(request, now)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not determine date range: %w", err)
+	}
+	var excludedDates []time.Time
+	for _, value := range request.ExcludedDates {
+		excludedDate, err := time.ParseInLocation(time.DateOnly, value, location)
+		if err != nil {
+			return nil, "", fmt.Errorf("excluded date must be formatted as YYYY-MM-DD: %s", value)
+		}
+		excludedDates = append(excludedDates, excludedDate)
+	}
+	firstDayName, lastDayName := lctime.Strftime("%x", firstDay), lctime.Strftime("%x", lastDay)
+	pollTitle := fmt.Sprintf(getOrDefault(request.Title, defaultRangePollTitle), firstDayName, lastDayName)
+	messageText := fmt.Sprintf(getOrDefault(request.Message, defaultRangePollMessage), firstDayName, lastDayName)
+	datePoll := poll.NewDateRangePoll(pollTitle, firstDay, lastDay, weekdays, startTimes, location, excludedDates, filter)
+	datePoll.Period = firstDay.Format(time.DateOnly) + "/" + lastDay.Format(time.DateOnly)
+	return datePoll, messageText, nil
+}
+
+func (b *Bot) EndPoll(request PollRequest) (response *PollResponse, err error) {
 	log.Printf("executing 'endPoll' request: %+v", request)
 	err = b.service.Open()
 	if err != nil {
@@ -142,7 +456,7 @@ func (b *Bot) EndPoll(request PollRequest) (err error) {
 		if closeErr := b.service.Close(); closeErr != nil {
 			log.Printf("could not close service: %v", closeErr)
 			if err == nil {
-				err = closeErr
+				response, err = nil, closeErr
 			}
 		}
 	}()
@@ -151,45 +465,743 @@ func (b *Bot) EndPoll(request PollRequest) (err error) {
 		log.Printf("could not load location '%s': %v", request.TimeZone, err)
 		return
 	}
-	result, err := b.service.GetLastPinnedPollResult(request.PollChannelID, location)
+	strategy, err := b.getResultStrategy(request)
+	if err != nil {
+		log.Printf("could not create result strategy: %v", err)
+		return
+	}
+	result, err := b.service.GetLastPinnedPollResult(request.PollChannelID, location, strategy, requiresVoters(request))
 	if err != nil {
 		log.Printf("could not retrieve last poll result: %v", err)
 		return
 	}
 	log.Printf("successfully retrieved last poll result: %+v", result)
+	tieBreaker, err := getTieBreaker(request, result, location)
+	if err != nil {
+		log.Printf("could not create tie breaker: %v", err)
+		return
+	}
+	if request.NoDateAction != "" && request.NoDateAction != noDateActionAnnounce && request.NoDateAction != noDateActionFollowUp {
+		log.Printf("unknown no date action: %s", request.NoDateAction)
+		return nil, fmt.Errorf("unknown no date action: %s", request.NoDateAction)
+	}
+	err = validateEvent(request)
+	if err != nil {
+		log.Printf("invalid event: %v", err)
+		return
+	}
+	runner, err := newStepRunner(request.FailureMode)
+	if err != nil {
+		log.Printf("invalid failure mode: %v", err)
+		return
+	}
+	// closing the poll cannot be undone, so it only happens after the whole request was validated
+	if !result.Finalized && request.Force {
+		result, err = b.closePolls(request, location, strategy, result.PollIDs)
+		if err != nil {
+			return
+		}
+	}
 	if !result.Finalized {
 		log.Printf("poll is not yet finalized")
-		return fmt.Errorf("poll is not yet finalized")
+		return nil, fmt.Errorf("poll is not yet finalized")
+	}
+	minVotes := defaultMinVotes
+	if request.MinVotes != nil {
+		minVotes = *request.MinVotes
+	}
+	if !result.MeetsThreshold(minVotes, request.Quorum) {
+		log.Printf("poll did not reach the threshold of %d votes for the winning date and %d distinct voters", minVotes, request.Quorum)
+		return b.handleNoDate(request, result, location)
+	}
+	if request.Runoff && !result.Runoff && len(result.WinningAnswers) > 1 {
+		var started bool
+		started, err = b.startRunoff(request, result, location)
+		if err != nil {
+			return
+		}
+		if started {
+			return &PollResponse{Outcome: OutcomeRunoff}, nil
+		}
+	}
+	b.unpinPollSteps(runner, request.PollChannelID, result.PollIDs)
+	tieBreak := poll.NewTieBreak(tieBreaker, result.WinningAnswers)
+	var eventID string
+	if request.CreateEvent {
+		runner.run(step{
+			name: "create event",
+			// the poll is already unpinned, so a failed event must not keep the winner from being announced
+			run: func() error {
+				var err error
+				eventID, err = b.createEvent(request, result, tieBreak.Winner)
+				if err != nil {
+					log.Printf("announcing winner without scheduled event")
+				}
+				return nil
+			},
+			compensate: func() error {
+				if eventID == "" {
+					return nil
+				}
+				return b.service.DeleteEvent(request.GuildID, eventID)
+			},
+		})
+	}
+	winnerVoters := result.VotersOf(tieBreak.Winner)
+	mentionVoters := request.MentionVoters && len(winnerVoters) > 0
+	content := fmt.Sprintf(getOrDefault(request.Message, defaultEndPollMessage), tieBreak.Winner.Unix())
+	if mentionVoters {
+		content = fmt.Sprintf(getOrDefault(request.Message, defaultWinnerMessage), tieBreak.Winner.Unix())
+	}
+	if tieBreak.IsTie() {
+		content += "\n" + fmt.Sprintf(defaultTieBreakMessage, formatTimestamps(tieBreak.Others), tieBreak.Description)
+	}
+	if eventID != "" {
+		content += "\n" + fmt.Sprintf(defaultEventMessage, request.GuildID, eventID)
+	}
+	if request.ShowTally {
+		content += "\n\n" + formatTally(result, tieBreak.Winner)
+	}
+	announcements := []*message.Message{message.NewMessage(content, true)}
+	if mentionVoters {
+		announcements = getVoterMentionMessages(request, result, tieBreak.Winner, winnerVoters, content)
 	}
-	err = b.service.UnpinPoll(request.PollChannelID, result.PollID)
+	b.announceSteps(runner, request.AnnouncementChannelID, announcements)
+	if runner.failed() {
+		return nil, runner.err()
+	}
+	return &PollResponse{Outcome: OutcomeWinner, Date: &tieBreak.Winner}, nil
+}
+
+func (b *Bot) RemindPoll(request PollRequest) (err error) {
+	log.Printf("executing 'remindPoll' request: %+v", request)
+	err = b.service.Open()
 	if err != nil {
-		log.Printf("could not unpin poll from poll channel: %v", err)
+		log.Printf("could not open service: %v", err)
 		return
 	}
-	log.Printf("service successfully unpinned poll from poll channel")
-	messageText := fmt.Sprintf(getOrDefault(request.Message, defaultEndPollMessage), getEarliestTime(result.WinningAnswers).Unix())
-	announcement := message.NewMessage(messageText, true)
-	messageID, err := b.service.SendMessage(request.AnnouncementChannelID, announcement)
+	defer func() {
+		if closeErr := b.service.Close(); closeErr != nil {
+			log.Printf("could not close service: %v", closeErr)
+			if err == nil {
+				err = closeErr
+			}
+		}
+	}()
+	location, err := time.LoadLocation(request.TimeZone)
 	if err != nil {
-		log.Printf("service could not send message to announcement channel: %v", err)
+		log.Printf("could not load location '%s': %v", request.TimeZone, err)
 		return
 	}
+	if request.ReminderRole != "" && request.GuildID == "" {
+		log.Printf("reminderRole requires guildId")
+		return fmt.Errorf("reminderRole requires guildId")
+	}
+	strategy, err := b.getResultStrategy(request)
+	if err != nil {
+		log.Printf("could not create result strategy: %v", err)
+		return
+	}
+	result, err := b.service.GetLastPinnedPollResult(request.PollChannelID, location, strategy, true)
+	if err != nil {
+		log.Printf("could not retrieve last poll result: %v", err)
+		return
+	}
+	log.Printf("successfully retrieved current poll result: %+v", result)
+	if result.Finalized {
+		log.Printf("poll is already finalized")
+		return fmt.Errorf("poll is already finalized")
+	}
+	deadline := defaultReminderDeadline
+	if !result.Expiry.IsZero() {
+		deadline = fmt.Sprintf("<t:%d:R>", result.Expiry.Unix())
+	}
+	content := fmt.Sprintf(getOrDefault(request.ReminderMessage, defaultReminderMessage), deadline) + "\n\n" + formatStandings(result, result.WinningAnswers)
+	reminders := []*message.Message{message.NewMessage("@here "+content, true)}
+	if request.ReminderRole != "" {
+		roleMembers, err := b.service.GetRoleMembers(request.GuildID, request.ReminderRole)
+		if err != nil {
+			log.Printf("service could not retrieve members of reminder role: %v", err)
+			return err
+		}
+		voters := result.Voters()
+		nonVoters := slices.DeleteFunc(roleMembers, func(member string) bool {
+			return slices.Contains(voters, member)
+		})
+		log.Printf("%d members of reminder role have not voted yet", len(nonVoters))
+		reminders = []*message.Message{message.NewMessage(content, false)}
+		if len(nonVoters) > 0 {
+			reminders = getMentionMessages(content, nonVoters)
+		}
+	}
+	for _, reminder := range reminders {
+		err = b.sendAnnouncement(request.AnnouncementChannelID, reminder)
+		if err != nil {
+			return
+		}
+	}
+	return nil
+}
+
+func (b *Bot) createEvent(request PollRequest, result *poll.DatePollResult, start time.Time) (string, error) {
+	durationHours := request.EventDurationHours
+	if durationHours <= 0 {
+		durationHours = defaultEventDuration
+	}
+	scheduledEvent := event.NewEvent(getOrDefault(request.EventName, result.Question), request.EventDescription, start, time.Duration(durationHours)*time.Hour, request.EventChannelID, request.EventLocation)
+	eventID, err := b.service.CreateEvent(request.GuildID, scheduledEvent)
+	if err != nil {
+		log.Printf("service could not create scheduled event: %v", err)
+		return "", err
+	}
+	log.Printf("service successfully created scheduled event: %s", eventID)
+	return eventID, nil
+}
+
+func validateEvent(request PollRequest) error {
+	if !request.CreateEvent {
+		return nil
+	}
+	if request.GuildID == "" {
+		return fmt.Errorf("createEvent requires guildId")
+	}
+	if request.EventChannelID == "" && request.EventLocation == "" {
+		return fmt.Errorf("createEvent requires eventChannelId or eventLocation")
+	}
+	return nil
+}
+
+func (b *Bot) closePolls(request PollRequest, location *time.Location, strategy poll.ResultStrategy, pollIDs []string) (*poll.DatePollResult, error) {
+	for _, pollID := range pollIDs {
+		err := b.service.ExpirePoll(request.PollChannelID, pollID)
+		if err != nil {
+			log.Printf("service could not expire poll: %v", err)
+			return nil, err
+		}
+		log.Printf("service successfully expired poll: %s", pollID)
+	}
+	// discord finalizes the counts of expired polls asynchronously, so the result is fetched until the counts are exact
+	for attempt := 1; ; attempt++ {
+		result, err := b.service.GetLastPinnedPollResult(request.PollChannelID, location, strategy, requiresVoters(request))
+		if err != nil {
+			log.Printf("could not retrieve closed poll result: %v", err)
+			return nil, err
+		}
+		if result.Finalized {
+			return result, nil
+		}
+		if attempt >= finalizeAttempts {
+			log.Printf("closed poll was not finalized after %d attempts", attempt)
+			return nil, fmt.Errorf("closed poll is not yet finalized, end it again later")
+		}
+		log.Printf("closed poll is not yet finalized, retrying in %s", finalizeDelay)
+		b.sleep(finalizeDelay)
+	}
+}
+
+func (b *Bot) handleNoDate(request PollRequest, result *poll.DatePollResult, location *time.Location) (*PollResponse, error) {
+	if request.NoDateAction == noDateActionFollowUp {
+		answerFormat, err := setupAnswerFormat(request)
+		if err != nil {
+			return nil, err
+		}
+		durationHours := request.FollowUpDurationHours
+		if durationHours <= 0 {
+			durationHours = defaultFollowUpDuration
+		}
+		now := b.now().In(location)
+		expiry := getFollowUpExpiry(now, durationHours)
+		followUpPoll := poll.NewFollowUpPoll(result.Question, result.Answers, expiry, answerFormat)
+		err = followUpPoll.ValidateExpiry(now)
+		if err != nil {
+			log.Printf("invalid follow-up poll expiry, followUpDurationHours sets when the poll closes: %v", err)
+			return nil, err
+		}
+		if len(followUpPoll.Answers) > 0 {
+			messageText := fmt.Sprintf(getOrDefault(request.FollowUpMessage, defaultFollowUpMessage), expiry.Unix())
+			err = b.postFollowUpPoll(request, result, followUpPoll, messageText)
+			if err != nil {
+				return nil, err
+			}
+			return &PollResponse{Outcome: OutcomeFollowUp}, nil
+		}
+		log.Printf("no dates left for a follow-up poll")
+	}
+	// the poll stays pinned, so that it can be ended again or inspected later
+	err := b.announce(request.AnnouncementChannelID, getOrDefault(request.NoDateMessage, defaultNoDateMessage))
+	if err != nil {
+		return nil, err
+	}
+	return &PollResponse{Outcome: OutcomeNoDate}, nil
+}
+
+// startRunoff reports whether a runoff poll was started, it needs at least two tied dates after the runoff closes
+func (b *Bot) startRunoff(request PollRequest, result *poll.DatePollResult, location *time.Location) (bool, error) {
+	answerFormat, err := setupAnswerFormat(request)
+	if err != nil {
+		return false, err
+	}
+	durationHours := request.RunoffDurationHours
+	if durationHours <= 0 {
+		durationHours = defaultRunoffDuration
+	}
+	now := b.now().In(location)
+	expiry := getFollowUpExpiry(now, durationHours)
+	runoffPoll := poll.NewRunoffPoll(fmt.Sprintf(defaultRunoffPollTitle, result.Question), result.WinningAnswers, expiry, answerFormat)
+	err = runoffPoll.ValidateExpiry(now)
+	if err != nil {
+		log.Printf("invalid runoff poll expiry, runoffDurationHours sets when the poll closes: %v", err)
+		return false, err
+	}
+	if len(runoffPoll.Answers) < 2 {
+		log.Printf("not enough tied dates left for a runoff poll, the tie breaker decides instead")
+		return false, nil
+	}
+	messageText := fmt.Sprintf(getOrDefault(request.RunoffMessage, defaultRunoffMessage), expiry.Unix())
+	return true, b.postFollowUpPoll(request, result, runoffPoll, messageText)
+}
+
+func (b *Bot) postFollowUpPoll(request PollRequest, result *poll.DatePollResult, followUpPoll *poll.DatePoll, messageText string) error {
+	runner, err := newStepRunner(request.FailureMode)
+	if err != nil {
+		return err
+	}
+	for _, pollPart := range followUpPoll.Split() {
+		b.postPollPart(runner, request.PollChannelID, pollPart, nil)
+	}
+	// the follow-up poll is pinned last, so the original poll can be unpinned without losing track of the vote
+	b.unpinPollSteps(runner, request.PollChannelID, result.PollIDs)
+	b.announceSteps(runner, request.AnnouncementChannelID, []*message.Message{message.NewMessage(messageText, true)})
+	return runner.err()
+}
+
+func (b *Bot) announce(channelID string, messageText string) error {
+	return b.sendAnnouncement(channelID, message.NewMessage(messageText, true))
+}
+
+func (b *Bot) sendAnnouncement(channelID string, announcement *message.Message) error {
+	messageID, err := b.service.SendMessage(channelID, announcement)
+	if err != nil {
+		log.Printf("service could not send message to announcement channel: %v", err)
+		return err
+	}
 	log.Printf("service successfully sent message to announcement channel: %s", messageID)
-	return
+	return nil
+}
+
+func (b *Bot) unpinPollSteps(runner *stepRunner, channelID string, pollIDs []string) {
+	for _, pollID := range pollIDs {
+		runner.run(step{
+			name: "unpin poll",
+			run: func() error {
+				err := b.service.UnpinPoll(channelID, pollID)
+				if err != nil {
+					log.Printf("could not unpin poll from poll channel: %v", err)
+					return err
+				}
+				log.Printf("service successfully unpinned poll from poll channel: %s", pollID)
+				return nil
+			},
+			compensate: func() error {
+				return b.service.PinPoll(channelID, pollID)
+			},
+		})
+	}
 }
 
-func getEarliestTime(times []time.Time) time.Time {
-	var earliest time.Time
-	for i, t := range times {
-		if i == 0 {
-			earliest = t
+// announceSteps sends every message in its own step, so that a rollback also deletes the parts of a split announcement
+func (b *Bot) announceSteps(runner *stepRunner, channelID string, announcements []*message.Message) {
+	for _, announcement := range announcements {
+		var messageID string
+		runner.run(step{
+			name: "send announcement",
+			run: func() error {
+				var err error
+				messageID, err = b.service.SendMessage(channelID, announcement)
+				if err != nil {
+					log.Printf("service could not send message to announcement channel: %v", err)
+					return err
+				}
+				log.Printf("service successfully sent message to announcement channel: %s", messageID)
+				return nil
+			},
+			compensate: func() error {
+				return b.service.DeleteMessage(channelID, messageID)
+			},
+		})
+	}
+}
+
+func setupAnswerFormat(request PollRequest) (string, error) {
+	locale := getOrDefault(request.Locale, defaultLocale)
+	err := lctime.SetLocale(locale)
+	if err != nil {
+		log.Printf("could not load locale: %s", locale)
+		return "", err
+	}
+	answerFormat, err := poll.ResolveAnswerFormat(request.AnswerFormat, locale)
+	if err != nil {
+		log.Printf("could not resolve answer format: %v", err)
+		return "", err
+	}
+	return answerFormat, nil
+}
+
+// discord rounds the poll duration down to full hours, the extra minute keeps the requested duration
+func getFollowUpExpiry(now time.Time, durationHours int) time.Time {
+	return now.Add(time.Duration(durationHours)*time.Hour + time.Minute)
+}
+
+func (b *Bot) getResultStrategy(request PollRequest) (poll.ResultStrategy, error) {
+	requiredMembers := slices.Clone(request.RequiredMembers)
+	if request.RequiredRole != "" {
+		if request.GuildID == "" {
+			return nil, fmt.Errorf("requiredRole requires guildId")
+		}
+		roleMembers, err := b.service.GetRoleMembers(request.GuildID, request.RequiredRole)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("service successfully retrieved %d members of required role", len(roleMembers))
+		requiredMembers = append(requiredMembers, roleMembers...)
+	}
+	strategy, err := poll.NewResultStrategy(request.ResultStrategy, request.ApprovalThreshold, request.RoleWeights, requiredMembers)
+	if err != nil {
+		return nil, err
+	}
+	if request.ResultStrategy == poll.StrategyRoleWeighted || len(request.EligibleRoles) > 0 {
+		if request.GuildID == "" {
+			return nil, fmt.Errorf("member roles require guildId")
+		}
+		memberRoles, err := b.service.GetMemberRoles(request.GuildID)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("service successfully retrieved roles of %d members", len(memberRoles))
+		strategy = poll.WithMemberRoles(poll.WithEligibleRoles(strategy, request.EligibleRoles, memberRoles), memberRoles)
+	}
+	if request.ResultStrategy == poll.StrategyRequiredMembers {
+		return strategy, nil
+	}
+	return poll.WithRequiredMembers(strategy, requiredMembers), nil
+}
+
+func getTieBreaker(request PollRequest, result *poll.DatePollResult, location *time.Location) (poll.TieBreaker, error) {
+	// unlike candidate weekdays, the weekday order has no default
+	var weekdayOrder []time.Weekday
+	if len(request.TieBreakWeekdays) > 0 {
+		var err error
+		weekdayOrder, err = getWeekdays(request.TieBreakWeekdays)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var conflicts []poll.DateMatcher
+	if request.TieBreak == poll.TieBreakFewestConflicts {
+		var err error
+		conflicts, err = getConflicts(request, location)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// poll ids are visible to every member, so anyone can repeat the draw
+	seed := getOrDefault(request.TieBreakSeed, strings.Join(result.PollIDs, ","))
+	return poll.NewTieBreaker(request.TieBreak, weekdayOrder, conflicts, seed)
+}
+
+// getConflicts only uses sources that keep dates as candidates, excluded dates can never be tied
+func getConflicts(request PollRequest, location *time.Location) ([]poll.DateMatcher, error) {
+	var conflicts []poll.DateMatcher
+	if request.HolidayMode == holidayModeFlag {
+		filter, err := getDateFilter(nil, nil, request.HolidayCalendars, request.HolidayCountry, request.HolidaySubdivision, holidayModeFlag, location)
+		if err != nil {
+			return nil, err
+		}
+		conflicts = filter.Flag
+	}
+	for _, source := range request.ConflictCalendars {
+		calendar, err := poll.LoadCalendar(source, location)
+		if err != nil {
+			return nil, err
+		}
+		for _, warning := range calendar.Warnings {
+			log.Printf("calendar '%s': %s", source, warning)
+		}
+		conflicts = append(conflicts, calendar)
+	}
+	if len(conflicts) == 0 {
+		return nil, fmt.Errorf("fewest conflicts tie break requires flagged holidays or conflict calendars")
+	}
+	return conflicts, nil
+}
+
+// requiresVoters reports whether ending the poll needs to know who voted, counts alone are not enough for a quorum
+func requiresVoters(request PollRequest) bool {
+	return request.MentionVoters || request.Quorum > 0
+}
+
+func formatTimestamps(times []time.Time) string {
+	var timestamps []string
+	for _, t := range times {
+		timestamps = append(timestamps, fmt.Sprintf("<t:%d:F>", t.Unix()))
+	}
+	return strings.Join(timestamps, ", ")
+}
+
+// getVoterMentionMessages only notifies the voters of the winner, other voters are mentioned silently
+func getVoterMentionMessages(request PollRequest, result *poll.DatePollResult, winner time.Time, winnerVoters []string, content string) []*message.Message {
+	messages := getMentionMessages(content, winnerVoters)
+	otherVoters := result.OtherVoters(winner)
+	if !request.NotifyOtherVoters || len(otherVoters) == 0 {
+		return messages
+	}
+	// every chunk is wrapped in its own line
+	lineLength := utf8.RuneCountInString(defaultOtherVoters)
+	for _, chunk := range chunkMentions(otherVoters, lineLength, lineLength) {
+		if len(chunk) == 0 {
 			continue
 		}
-		if t.Before(earliest) {
-			earliest = t
+		line := fmt.Sprintf(defaultOtherVoters, formatMentions(chunk))
+		if last := messages[len(messages)-1]; utf8.RuneCountInString(last.Content+"\n"+line) <= maxMessageLength {
+			last.Content += "\n" + line
+			continue
+		}
+		messages = append(messages, message.NewUserMentionMessage(line, []string{}))
+	}
+	return messages
+}
+
+// getMentionMessages puts the mentions in front of the content, mentions beyond discord's limits continue in further messages
+func getMentionMessages(content string, userIDs []string) []*message.Message {
+	chunks := chunkMentions(userIDs, utf8.RuneCountInString(content)+1, 0)
+	messages := []*message.Message{message.NewUserMentionMessage(strings.TrimSpace(formatMentions(chunks[0])+" "+content), chunks[0])}
+	for _, chunk := range chunks[1:] {
+		messages = append(messages, message.NewUserMentionMessage(formatMentions(chunk), chunk))
+	}
+	return messages
+}
+
+// chunkMentions splits the users, so that neither the length nor the number of mentions of a message exceeds discord's limits,
+// the first chunk shares its message with text of the given length and is empty if no mention fits next to it,
+// every further chunk reserves the given length for the text that surrounds its mentions
+func chunkMentions(userIDs []string, firstSharedLength int, sharedLength int) [][]string {
+	chunks := [][]string{{}}
+	length := firstSharedLength
+	for _, userID := range userIDs {
+		mentionLength := len(userID) + len("<@> ")
+		if last := chunks[len(chunks)-1]; len(last) == maxMentionsPerMessage || length+mentionLength > maxMessageLength {
+			chunks = append(chunks, []string{})
+			length = sharedLength
+		}
+		chunks[len(chunks)-1] = append(chunks[len(chunks)-1], userID)
+		length += mentionLength
+	}
+	return chunks
+}
+
+func formatMentions(userIDs []string) string {
+	var mentions []string
+	for _, userID := range userIDs {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", userID))
+	}
+	return strings.Join(mentions, " ")
+}
+
+func formatTally(result *poll.DatePollResult, winner time.Time) string {
+	tally := formatStandings(result, []time.Time{winner})
+	if runnerUp := result.RunnerUp(winner); runnerUp != nil {
+		tally += "\n" + fmt.Sprintf(defaultRunnerUpMessage, runnerUp.Date.Unix(), formatVotes(*runnerUp))
+	}
+	return tally
+}
+
+func formatStandings(result *poll.DatePollResult, leaders []time.Time) string {
+	lines := []string{fmt.Sprintf(defaultTallyTitle, result.TotalVotes)}
+	maxVotes := 0
+	for _, score := range result.Scores {
+		maxVotes = max(maxVotes, score.Votes)
+	}
+	for _, score := range result.Scores {
+		line := fmt.Sprintf("`%s` %s <t:%d:F>", formatTallyBar(score.Votes, maxVotes), formatVotes(score), score.Date.Unix())
+		if slices.ContainsFunc(leaders, score.Date.Equal) {
+			line += " :trophy:"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatTallyBar(votes int, maxVotes int) string {
+	filled := 0
+	if maxVotes > 0 {
+		filled = (votes*tallyBarWidth + maxVotes/2) / maxVotes
+	}
+	return strings.Repeat("█", filled) + strings.Repeat("░", tallyBarWidth-filled)
+}
+
+// formatVotes adds the score when a strategy weighted the votes
+func formatVotes(score poll.AnswerScore) string {
+	text := fmt.Sprintf("%d votes", score.Votes)
+	if score.Votes == 1 {
+		text = "1 vote"
+	}
+	if score.Score != float64(score.Votes) {
+		text += fmt.Sprintf(" (score %s)", strconv.FormatFloat(score.Score, 'f', -1, 64))
+	}
+	return text
+}
+
+func getTargetMonth(targetMonth string, monthOffset *int, now time.Time) (time.Time, error) {
+	if targetMonth != "" && monthOffset != nil {
+		return time.Time{}, fmt.Errorf("target month and month offset must not be combined")
+	}
+	if targetMonth != "" {
+		parsed, err := time.ParseInLocation("2006-01", targetMonth, now.Location())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("target month must be formatted as YYYY-MM: %s", targetMonth)
+		}
+		return parsed, nil
+	}
+	offset := defaultMonthOffset
+	if monthOffset != nil {
+		offset = *monthOffset
+	}
+	// normalize to the first day so that adding months never overflows into the following month
+	return time.Date(now.Year(), now.Month()+time.Month(offset), 1, 0, 0, 0, 0, now.Location()), nil
+}
+
+func isDateRangeRequest(request PollRequest) bool {
+	return request.StartDate != "" || request.EndDate != "" || request.StartInDays != nil || request.DurationDays != 0
+}
+
+func getDateRange(request PollRequest, now time.Time) (time.Time, time.Time, error) {
+	var firstDay, lastDay time.Time
+	switch {
+	case request.StartDate != "" && request.StartInDays != nil:
+		return time.Time{}, time.Time{}, fmt.Errorf("start date and start in days must not be combined")
+	case request.StartDate != "":
+		var err error
+		firstDay, err = time.ParseInLocation(time.DateOnly, request.StartDate, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("start date must be formatted as YYYY-MM-DD: %s", request.StartDate)
+		}
+	case request.StartInDays != nil:
+		firstDay = time.Date(now.Year(), now.Month(), now.Day()+*request.StartInDays, 0, 0, 0, 0, now.Location())
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("either start date or start in days is required")
+	}
+	switch {
+	case request.EndDate != "" && request.DurationDays != 0:
+		return time.Time{}, time.Time{}, fmt.Errorf("end date and duration days must not be combined")
+	case request.EndDate != "":
+		var err error
+		lastDay, err = time.ParseInLocation(time.DateOnly, request.EndDate, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("end date must be formatted as YYYY-MM-DD: %s", request.EndDate)
+		}
+	case request.DurationDays > 0:
+		lastDay = time.Date(firstDay.Year(), firstDay.Month(), firstDay.Day()+request.DurationDays-1, 0, 0, 0, 0, now.Location())
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("either end date or a positive number of duration days is required")
+	}
+	if lastDay.Before(firstDay) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end date must not be before start date")
+	}
+	return firstDay, lastDay, nil
+}
+
+func getWeekdays(values []Weekday) ([]time.Weekday, error) {
+	if len(values) == 0 {
+		return defaultWeekdays, nil
+	}
+	var weekdays []time.Weekday
+	for _, value := range values {
+		weekday, err := poll.ParseWeekday(string(value))
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(weekdays, weekday) {
+			weekdays = append(weekdays, weekday)
+		}
+	}
+	return weekdays, nil
+}
+
+func getStartTimes(startTime string, weekdayStartTimes map[string]string) (poll.StartTimes, error) {
+	defaultTime := poll.DefaultStartTime
+	if startTime != "" {
+		var err error
+		defaultTime, err = poll.ParseTimeOfDay(startTime)
+		if err != nil {
+			return poll.StartTimes{}, err
+		}
+	}
+	weekdayTimes := make(map[time.Weekday]poll.TimeOfDay, len(weekdayStartTimes))
+	for weekdayValue, timeValue := range weekdayStartTimes {
+		weekday, err := poll.ParseWeekday(weekdayValue)
+		if err != nil {
+			return poll.StartTimes{}, err
+		}
+		weekdayTime, err := poll.ParseTimeOfDay(timeValue)
+		if err != nil {
+			return poll.StartTimes{}, err
+		}
+		weekdayTimes[weekday] = weekdayTime
+	}
+	return poll.NewStartTimes(defaultTime, weekdayTimes), nil
+}
+
+func getDateFilter(includeRules []string, excludeRules []string, holidayCalendars []string, holidayCountry string, holidaySubdivision string, holidayMode string, location *time.Location) (poll.DateFilter, error) {
+	include, err := parseRules(includeRules)
+	if err != nil {
+		return poll.DateFilter{}, err
+	}
+	exclude, err := parseRules(excludeRules)
+	if err != nil {
+		return poll.DateFilter{}, err
+	}
+	var holidays []poll.DateMatcher
+	for _, source := range holidayCalendars {
+		calendar, err := poll.LoadCalendar(source, location)
+		if err != nil {
+			return poll.DateFilter{}, err
+		}
+		for _, warning := range calendar.Warnings {
+			log.Printf("calendar '%s': %s", source, warning)
+		}
+		holidays = append(holidays, calendar)
+	}
+	if holidayCountry != "" {
+		calendar, err := poll.NewHolidayCalendar(holidayCountry, holidaySubdivision)
+		if err != nil {
+			return poll.DateFilter{}, err
+		}
+		holidays = append(holidays, calendar)
+	} else if holidaySubdivision != "" {
+		return poll.DateFilter{}, fmt.Errorf("holidaySubdivision requires holidayCountry")
+	}
+	var flag []poll.DateMatcher
+	switch holidayMode {
+	case "", holidayModeExclude:
+		exclude = append(exclude, holidays...)
+	case holidayModeFlag:
+		flag = holidays
+	default:
+		return poll.DateFilter{}, fmt.Errorf("unknown holiday mode: %s", holidayMode)
+	}
+	return poll.NewDateFilter(include, exclude, flag), nil
+}
+
+func parseRules(values []string) ([]poll.DateMatcher, error) {
+	var rules []poll.DateMatcher
+	for _, value := range values {
+		rule, err := poll.ParseRule(value)
+		if err != nil {
+			return nil, err
 		}
+		rules = append(rules, rule)
 	}
-	return earliest
+	return rules, nil
 }
 
 func getOrDefault(value string, defaultValue string) string {
@@ -1,10 +1,16 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
+	"github.com/paschi/discord-date-decider/internal/discord"
+	"github.com/paschi/discord-date-decider/internal/event"
 	"github.com/paschi/discord-date-decider/internal/message"
 	"github.com/paschi/discord-date-decider/internal/poll"
 	"github.com/stretchr/testify/assert"
@@ -45,23 +51,96 @@ func (m *MockService) UnpinPoll(channelID string, pollID string) error {
 	return args.Error(0)
 }
 
-func (m *MockService) GetLastPinnedPollResult(channelID string, location *time.Location) (*poll.DatePollResult, error) {
-	args := m.Called(channelID, location)
+func (m *MockService) DeleteMessage(channelID string, messageID string) error {
+	args := m.Called(channelID, messageID)
+	return args.Error(0)
+}
+
+func (m *MockService) GetRoleMembers(guildID string, roleID string) ([]string, error) {
+	args := m.Called(guildID, roleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockService) GetMemberRoles(guildID string) (map[string][]string, error) {
+	args := m.Called(guildID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+
+func (m *MockService) GetLastPinnedPollResult(channelID string, location *time.Location, strategy poll.ResultStrategy, withVoters bool) (*poll.DatePollResult, error) {
+	args := m.Called(channelID, location, strategy, withVoters)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*poll.DatePollResult), args.Error(1)
 }
 
+func (m *MockService) CreateEvent(guildID string, event *event.Event) (string, error) {
+	args := m.Called(guildID, event)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockService) DeleteEvent(guildID string, eventID string) error {
+	args := m.Called(guildID, eventID)
+	return args.Error(0)
+}
+
+func (m *MockService) FindPolls(channelID string, period string, profile string) ([]poll.PostedPoll, error) {
+	args := m.Called(channelID, period, profile)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]poll.PostedPoll), args.Error(1)
+}
+
+func (m *MockService) MarkPollAnnounced(channelID string, pollID string) error {
+	args := m.Called(channelID, pollID)
+	return args.Error(0)
+}
+
 func (m *MockService) ExpirePoll(channelID string, pollID string) error {
 	args := m.Called(channelID, pollID)
 	return args.Error(0)
 }
 
+func TestNewClient(t *testing.T) {
+	parameters := []struct {
+		name         string
+		mode         string
+		expectedType discord.Client
+		expectError  bool
+	}{
+		{name: "rest client by default", mode: "", expectedType: &discord.RestClient{}},
+		{name: "rest client", mode: "rest", expectedType: &discord.RestClient{}},
+		{name: "gateway client", mode: "gateway", expectedType: &discord.DefaultClient{}},
+		{name: "unknown mode", mode: "webhook", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			client, err := newClient(parameter.mode, "token")
+
+			if parameter.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, client)
+			} else {
+				assert.NoError(t, err)
+				assert.IsType(t, parameter.expectedType, client)
+			}
+		})
+	}
+}
+
 func TestStartPoll(t *testing.T) {
 	t.Run("successful poll start", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		pollChannelID := "poll-channel-id"
 		announcementChannelID := "announcement-channel-id"
 		pollID := "poll-id"
@@ -72,20 +151,153 @@ func TestStartPoll(t *testing.T) {
 			AnnouncementChannelID: announcementChannelID,
 		}
 		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
 		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
 		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
 		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful resume of poll that was sent but not pinned", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			Profile:               "game-night",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			TargetMonth:           "2026-11",
+		}
+		postedPolls := []poll.PostedPoll{{ID: pollID}}
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-11", "game-night").Return(postedPolls, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("successful resume of pinned poll without announcement", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		request := PollRequest{Action: "startPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", TargetMonth: "2026-11"}
+		postedPolls := []poll.PostedPoll{{ID: pollID, Pinned: true}}
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-11", "").Return(postedPolls, nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+		mockService.AssertNotCalled(t, "PinPoll")
+	})
+
+	t.Run("successful skip of poll that was already announced", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		request := PollRequest{Action: "startPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", TargetMonth: "2026-11"}
+		postedPolls := []poll.PostedPoll{{ID: pollID, Pinned: true, Announced: true}}
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-11", "").Return(postedPolls, nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+		mockService.AssertNotCalled(t, "PinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("successful resume of missing poll part in existing group", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			TargetMonth:           "2026-10",
+			Weekdays:              []Weekday{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+		}
+		postedPolls := []poll.PostedPoll{{ID: "poll-id-1", GroupID: "group-id", Part: 1, Pinned: true}}
+		secondPart := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			return datePoll.Part == 2 && datePoll.GroupID == "group-id"
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-10", "").Return(postedPolls, nil)
+		mockService.On("SendPoll", pollChannelID, secondPart).Return("poll-id-2", nil)
+		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return("poll-id-3", nil)
+		mockService.On("PinPoll", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, "poll-id-1").Return(nil)
 		mockService.On("Close").Return(nil)
 
 		err := bot.StartPoll(request)
 
 		assert.NoError(t, err)
+		mockService.AssertCalled(t, "SendPoll", pollChannelID, secondPart)
+		mockService.AssertNotCalled(t, "PinPoll", pollChannelID, "poll-id-1")
+	})
+
+	t.Run("error finding posted polls", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		request := PollRequest{Action: "startPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, assert.AnError)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Equal(t, assert.AnError, err)
 		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
 	})
 
 	t.Run("error during open", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		request := PollRequest{
 			Action:                "startPoll",
 			PollChannelID:         "poll-channel-id",
@@ -106,6 +318,7 @@ func TestStartPoll(t *testing.T) {
 	t.Run("error during send poll", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		pollChannelID := "poll-channel-id"
 		announcementChannelID := "announcement-channel-id"
 		request := PollRequest{
@@ -114,6 +327,7 @@ func TestStartPoll(t *testing.T) {
 			AnnouncementChannelID: announcementChannelID,
 		}
 		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
 		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return("", assert.AnError)
 		mockService.On("Close").Return(nil)
 
@@ -128,6 +342,7 @@ func TestStartPoll(t *testing.T) {
 	t.Run("error during pin poll", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		pollChannelID := "poll-channel-id"
 		announcementChannelID := "announcement-channel-id"
 		pollID := "poll-id"
@@ -137,6 +352,7 @@ func TestStartPoll(t *testing.T) {
 			AnnouncementChannelID: announcementChannelID,
 		}
 		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
 		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
 		mockService.On("PinPoll", pollChannelID, pollID).Return(assert.AnError)
 		mockService.On("Close").Return(nil)
@@ -148,9 +364,10 @@ func TestStartPoll(t *testing.T) {
 		mockService.AssertNotCalled(t, "SendMessage")
 	})
 
-	t.Run("error during send message", func(t *testing.T) {
+	t.Run("rollback deletes poll after error during pin poll", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		pollChannelID := "poll-channel-id"
 		announcementChannelID := "announcement-channel-id"
 		pollID := "poll-id"
@@ -158,46 +375,57 @@ func TestStartPoll(t *testing.T) {
 			Action:                "startPoll",
 			PollChannelID:         pollChannelID,
 			AnnouncementChannelID: announcementChannelID,
+			FailureMode:           "rollback",
 		}
 		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
 		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
-		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
-		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(assert.AnError)
+		mockService.On("DeleteMessage", pollChannelID, pollID).Return(nil)
 		mockService.On("Close").Return(nil)
 
 		err := bot.StartPoll(request)
 
-		assert.Error(t, err)
+		assert.Equal(t, assert.AnError, err)
 		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendMessage")
 	})
 
-	t.Run("error during close", func(t *testing.T) {
+	t.Run("rollback reports error during delete poll", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		pollChannelID := "poll-channel-id"
 		announcementChannelID := "announcement-channel-id"
 		pollID := "poll-id"
-		messageID := "message-id"
+		deleteErr := errors.New("delete error")
 		request := PollRequest{
 			Action:                "startPoll",
 			PollChannelID:         pollChannelID,
 			AnnouncementChannelID: announcementChannelID,
+			FailureMode:           "rollback",
 		}
 		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
 		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
 		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
-		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
-		mockService.On("Close").Return(assert.AnError)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("DeleteMessage", pollChannelID, pollID).Return(deleteErr)
+		mockService.On("Close").Return(nil)
 
 		err := bot.StartPoll(request)
 
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorIs(t, err, deleteErr)
 		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "MarkPollAnnounced", mock.Anything, mock.Anything)
 	})
 
-	t.Run("error during close does not override other errors", func(t *testing.T) {
+	t.Run("continue marks poll after error during send message", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		pollChannelID := "poll-channel-id"
 		announcementChannelID := "announcement-channel-id"
 		pollID := "poll-id"
@@ -205,128 +433,1842 @@ func TestStartPoll(t *testing.T) {
 			Action:                "startPoll",
 			PollChannelID:         pollChannelID,
 			AnnouncementChannelID: announcementChannelID,
+			FailureMode:           "continue",
 		}
-		expectedErr := errors.New("some error")
-		closeErr := errors.New("error during close")
 		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
 		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
 		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
-		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", expectedErr)
-		mockService.On("Close").Return(closeErr)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("MarkPollAnnounced", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
 
 		err := bot.StartPoll(request)
 
-		assert.Error(t, err)
-		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, assert.AnError, err)
 		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "DeleteMessage", mock.Anything, mock.Anything)
 	})
-}
-
-func TestEndPoll(t *testing.T) {
-	pollChannelID := "poll-channel-id"
-	announcementChannelID := "announcement-channel-id"
-	messageID := "message-id"
-	pollID := "poll-id"
 
-	t.Run("successful poll end", func(t *testing.T) {
+	t.Run("error invalid failure mode", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
 		request := PollRequest{
-			Action:                "endPoll",
-			PollChannelID:         pollChannelID,
-			AnnouncementChannelID: announcementChannelID,
-			TimeZone:              "UTC",
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			FailureMode:           "retry",
 		}
-		winning := []time.Time{time.Unix(3000, 0).UTC(), time.Unix(2000, 0).UTC()}
-		result := poll.NewDatePollResult(pollID, winning, true)
 		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return(result, nil)
-		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
-		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
 		mockService.On("Close").Return(nil)
 
-		err := bot.EndPoll(request)
+		err := bot.StartPoll(request)
 
-		assert.NoError(t, err)
+		assert.Error(t, err)
 		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll", mock.Anything, mock.Anything)
 	})
 
-	t.Run("error during open", func(t *testing.T) {
+	t.Run("error during send message", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
-		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
-		mockService.On("Open").Return(assert.AnError)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("Close").Return(nil)
 
-		err := bot.EndPoll(request)
+		err := bot.StartPoll(request)
 
 		assert.Error(t, err)
 		mockService.AssertExpectations(t)
-		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
-		mockService.AssertNotCalled(t, "UnpinPoll")
-		mockService.AssertNotCalled(t, "SendMessage")
-		mockService.AssertNotCalled(t, "Close")
 	})
 
-	t.Run("error invalid timezone", func(t *testing.T) {
+	t.Run("error during close", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
-		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "Invalid/Zone"}
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+		}
 		mockService.On("Open").Return(nil)
-		mockService.On("Close").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("Close").Return(assert.AnError)
 
-		err := bot.EndPoll(request)
+		err := bot.StartPoll(request)
 
 		assert.Error(t, err)
 		mockService.AssertExpectations(t)
-		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
-		mockService.AssertNotCalled(t, "UnpinPoll")
-		mockService.AssertNotCalled(t, "SendMessage")
 	})
 
-	t.Run("error get last pinned poll result", func(t *testing.T) {
+	t.Run("error during close does not override other errors", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
-		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+		}
+		expectedErr := errors.New("some error")
+		closeErr := errors.New("error during close")
 		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return((*poll.DatePollResult)(nil), assert.AnError)
-		mockService.On("Close").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", expectedErr)
+		mockService.On("Close").Return(closeErr)
 
-		err := bot.EndPoll(request)
+		err := bot.StartPoll(request)
 
 		assert.Error(t, err)
+		assert.Equal(t, expectedErr, err)
 		mockService.AssertExpectations(t)
-		mockService.AssertNotCalled(t, "UnpinPoll")
-		mockService.AssertNotCalled(t, "SendMessage")
 	})
 
-	t.Run("error poll not finalized", func(t *testing.T) {
+	t.Run("successful poll start with custom weekdays", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
-		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
-		res := poll.NewDatePollResult(pollID, []time.Time{time.Unix(1000, 0).UTC()}, false)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			Weekdays:              []Weekday{"tuesday", "3"},
+		}
+		onlyTuesdaysAndWednesdays := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			for _, answer := range datePoll.Answers {
+				if answer.Weekday() != time.Tuesday && answer.Weekday() != time.Wednesday {
+					return false
+				}
+			}
+			return len(datePoll.Answers) > 0
+		})
 		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return(res, nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, onlyTuesdaysAndWednesdays).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
 		mockService.On("Close").Return(nil)
 
-		err := bot.EndPoll(request)
+		err := bot.StartPoll(request)
 
-		assert.Error(t, err)
+		assert.NoError(t, err)
 		mockService.AssertExpectations(t)
-		mockService.AssertNotCalled(t, "UnpinPoll")
-		mockService.AssertNotCalled(t, "SendMessage")
 	})
 
-	t.Run("error during unpin poll", func(t *testing.T) {
+	t.Run("successful poll start split across poll parts", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
-		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
-		res := poll.NewDatePollResult(pollID, []time.Time{time.Unix(1000, 0).UTC()}, true)
-		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return(res, nil)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TargetMonth:           "2026-10",
+			Weekdays:              []Weekday{"thursday", "friday", "saturday"},
+		}
+		part := func(number int) interface{} {
+			return mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+				return datePoll.Part == number && datePoll.Parts == 2
+			})
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, part(1)).Return("poll-id-1", nil)
+		mockService.On("SendPoll", pollChannelID, part(2)).Return("poll-id-2", nil)
+		mockService.On("PinPoll", pollChannelID, "poll-id-1").Return(nil)
+		mockService.On("PinPoll", pollChannelID, "poll-id-2").Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil).Once()
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll start for target month", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 15, 23, 30, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "Europe/Berlin",
+			TargetMonth:           "2026-11",
+		}
+		novemberPoll := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			return datePoll.Question == "Poll for November 2026" && datePoll.Answers[0].Month() == time.November
+		})
+		novemberAnnouncement := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "November")
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-11", "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, novemberPoll).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, novemberAnnouncement).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll start for date range", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		startInDays := 2
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			StartInDays:           &startInDays,
+			DurationDays:          14,
+			ExcludedDates:         []string{"2026-10-24"},
+		}
+		rangePoll := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			return datePoll.Question == "Poll for 10/19/2026 - 11/01/2026" &&
+				datePoll.Expiry.Equal(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)) &&
+				len(datePoll.Answers) == 3
+		})
+		rangeAnnouncement := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "10/19/2026 - 11/01/2026")
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-10-19/2026-11-01", "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, rangePoll).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, rangeAnnouncement).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful short notice poll with voting duration", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		now := time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC)
+		bot.now = func() time.Time { return now }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		startInDays := 0
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			StartInDays:           &startInDays,
+			DurationDays:          14,
+			VotingDurationHours:   24,
+		}
+		shortNoticePoll := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			// today's date is over before the poll closes, so it is no longer a candidate
+			return datePoll.Expiry.Equal(now.Add(24*time.Hour+time.Minute)) &&
+				len(datePoll.Answers) == 3 && datePoll.Answers[0].Equal(time.Date(2026, 10, 23, 20, 0, 0, 0, time.UTC))
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, "2026-10-17/2026-10-30", "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, shortNoticePoll).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("message-id", nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("error poll that would already be closed", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC) }
+		startInDays := 1
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			TimeZone:              "UTC",
+			StartInDays:           &startInDays,
+			DurationDays:          14,
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "FindPolls", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error poll that would close after more than 32 days", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC) }
+		monthOffset := 3
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			TimeZone:              "UTC",
+			MonthOffset:           &monthOffset,
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "FindPolls", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error date range combined with target month", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			TargetMonth:           "2026-11",
+			StartDate:             "2026-11-01",
+			EndDate:               "2026-11-14",
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("error invalid rule", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			ExcludeRules:          []string{"FREQ=DAILY"},
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("error invalid target month", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			TargetMonth:           "November",
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("successful poll start with answer format preset", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		pollChannelID := "poll-channel-id"
+		announcementChannelID := "announcement-channel-id"
+		pollID := "poll-id"
+		messageID := "message-id"
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			Locale:                "de_DE",
+			AnswerFormat:          "iso",
+		}
+		isoAnswers := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			return datePoll.AnswerFormat == "%Y-%m-%d %H:%M"
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("FindPolls", pollChannelID, mock.AnythingOfType("string"), "").Return(nil, nil)
+		mockService.On("SendPoll", pollChannelID, isoAnswers).Return(pollID, nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("MarkPollAnnounced", pollChannelID, mock.AnythingOfType("string")).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("error answer format exceeds answer length", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			AnswerFormat:          "%A, %B %d %Y at %H:%M o'clock, please vote for your favourite date",
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("error invalid answer format", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			AnswerFormat:          "medium",
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("error invalid start time", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			StartTime:             "25:00",
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("error invalid weekdays", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 9, 15, 9, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "startPoll",
+			PollChannelID:         "poll-channel-id",
+			AnnouncementChannelID: "announcement-channel-id",
+			Weekdays:              []Weekday{"someday"},
+		}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.StartPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+		mockService.AssertNotCalled(t, "PinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+}
+
+func TestPollRequest_UnmarshalJSON(t *testing.T) {
+	t.Run("weekdays as names and iso numbers", func(t *testing.T) {
+		var request PollRequest
+
+		err := json.Unmarshal([]byte(`{"action":"startPoll","weekdays":["tuesday",3,"Sun"]}`), &request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Weekday{"tuesday", "3", "Sun"}, request.Weekdays)
+	})
+
+	t.Run("error weekday of unsupported type", func(t *testing.T) {
+		var request PollRequest
+
+		err := json.Unmarshal([]byte(`{"action":"startPoll","weekdays":[true]}`), &request)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetWeekdays(t *testing.T) {
+	parameters := []struct {
+		name             string
+		values           []Weekday
+		expectedWeekdays []time.Weekday
+		expectError      bool
+	}{
+		{name: "default weekdays", values: nil, expectedWeekdays: []time.Weekday{time.Friday, time.Saturday}},
+		{name: "names and numbers", values: []Weekday{"monday", "4"}, expectedWeekdays: []time.Weekday{time.Monday, time.Thursday}},
+		{name: "duplicates are removed", values: []Weekday{"friday", "5", "Fri"}, expectedWeekdays: []time.Weekday{time.Friday}},
+		{name: "invalid weekday", values: []Weekday{"friday", "8"}, expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			weekdays, err := getWeekdays(parameter.values)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedWeekdays, weekdays)
+			}
+		})
+	}
+}
+
+func TestGetTargetMonth(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	offset := func(value int) *int { return &value }
+	parameters := []struct {
+		name          string
+		targetMonth   string
+		monthOffset   *int
+		now           time.Time
+		expectedMonth time.Time
+		expectError   bool
+	}{
+		{
+			name:          "default offset at the end of january",
+			now:           time.Date(2026, 1, 31, 12, 0, 0, 0, berlin),
+			expectedMonth: time.Date(2026, 2, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "default offset in december",
+			now:           time.Date(2025, 12, 15, 20, 0, 0, 0, berlin),
+			expectedMonth: time.Date(2026, 1, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "default offset uses the request time zone",
+			now:           time.Date(2026, 4, 30, 23, 30, 0, 0, time.UTC).In(berlin),
+			expectedMonth: time.Date(2026, 6, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "custom offset",
+			monthOffset:   offset(0),
+			now:           time.Date(2026, 3, 31, 12, 0, 0, 0, berlin),
+			expectedMonth: time.Date(2026, 3, 1, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:          "explicit target month",
+			targetMonth:   "2026-11",
+			now:           time.Date(2026, 1, 31, 12, 0, 0, 0, berlin),
+			expectedMonth: time.Date(2026, 11, 1, 0, 0, 0, 0, berlin),
+		},
+		{name: "invalid target month", targetMonth: "2026-13", now: time.Now(), expectError: true},
+		{name: "target month combined with offset", targetMonth: "2026-11", monthOffset: offset(1), now: time.Now(), expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			targetMonth, err := getTargetMonth(parameter.targetMonth, parameter.monthOffset, parameter.now)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedMonth, targetMonth)
+			}
+		})
+	}
+}
+
+func TestGetDateRange(t *testing.T) {
+	now := time.Date(2026, 10, 17, 21, 0, 0, 0, time.UTC)
+	days := func(value int) *int { return &value }
+	parameters := []struct {
+		name             string
+		request          PollRequest
+		expectedFirstDay time.Time
+		expectedLastDay  time.Time
+		expectError      bool
+	}{
+		{
+			name:             "absolute start and end date",
+			request:          PollRequest{StartDate: "2026-12-01", EndDate: "2027-01-11"},
+			expectedFirstDay: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			expectedLastDay:  time.Date(2027, 1, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "relative start with duration",
+			request:          PollRequest{StartInDays: days(1), DurationDays: 14},
+			expectedFirstDay: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+			expectedLastDay:  time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:             "absolute start with duration",
+			request:          PollRequest{StartDate: "2026-12-28", DurationDays: 7},
+			expectedFirstDay: time.Date(2026, 12, 28, 0, 0, 0, 0, time.UTC),
+			expectedLastDay:  time.Date(2027, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{name: "missing start", request: PollRequest{DurationDays: 14}, expectError: true},
+		{name: "missing end", request: PollRequest{StartDate: "2026-12-01"}, expectError: true},
+		{name: "start date combined with start in days", request: PollRequest{StartDate: "2026-12-01", StartInDays: days(1), DurationDays: 1}, expectError: true},
+		{name: "end date combined with duration", request: PollRequest{StartDate: "2026-12-01", EndDate: "2026-12-14", DurationDays: 14}, expectError: true},
+		{name: "end before start", request: PollRequest{StartDate: "2026-12-14", EndDate: "2026-12-01"}, expectError: true},
+		{name: "invalid start date", request: PollRequest{StartDate: "01.12.2026", EndDate: "2026-12-14"}, expectError: true},
+		{name: "invalid end date", request: PollRequest{StartDate: "2026-12-01", EndDate: "14.12.2026"}, expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			firstDay, lastDay, err := getDateRange(parameter.request, now)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedFirstDay, firstDay)
+				assert.Equal(t, parameter.expectedLastDay, lastDay)
+			}
+		})
+	}
+}
+
+func TestGetDateFilter(t *testing.T) {
+	t.Run("include and exclude rules", func(t *testing.T) {
+		filter, err := getDateFilter(
+			[]string{"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=26,27,28,29,30"},
+			[]string{"FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=23,24,25,31"},
+			nil,
+			"",
+			"",
+			"",
+			time.UTC,
+		)
+
+		assert.NoError(t, err)
+		assert.True(t, filter.Includes(time.Date(2026, 12, 28, 20, 0, 0, 0, time.UTC)))
+		assert.False(t, filter.Includes(time.Date(2026, 11, 28, 20, 0, 0, 0, time.UTC)))
+		assert.True(t, filter.Excludes(time.Date(2026, 12, 24, 20, 0, 0, 0, time.UTC)))
+		assert.False(t, filter.Excludes(time.Date(2026, 12, 26, 20, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		filter, err := getDateFilter(nil, nil, nil, "", "", "", time.UTC)
+
+		assert.NoError(t, err)
+		assert.False(t, filter.Includes(time.Date(2026, 12, 28, 20, 0, 0, 0, time.UTC)))
+		assert.False(t, filter.Excludes(time.Date(2026, 12, 28, 20, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("bundled holiday calendar", func(t *testing.T) {
+		filter, err := getDateFilter(nil, nil, []string{"bundled:de-fixed-holidays"}, "", "", "", time.UTC)
+
+		assert.NoError(t, err)
+		assert.True(t, filter.Excludes(time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)))
+		assert.False(t, filter.Excludes(time.Date(2026, 10, 2, 20, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("computed holidays are excluded by default", func(t *testing.T) {
+		filter, err := getDateFilter(nil, nil, nil, "DE", "BY", "", time.UTC)
+
+		assert.NoError(t, err)
+		assert.True(t, filter.Excludes(time.Date(2026, 6, 4, 20, 0, 0, 0, time.UTC)))
+		assert.False(t, filter.Flags(time.Date(2026, 6, 4, 20, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("computed and bundled holidays are flagged", func(t *testing.T) {
+		filter, err := getDateFilter(nil, nil, []string{"bundled:de-fixed-holidays"}, "DE", "", "flag", time.UTC)
+
+		assert.NoError(t, err)
+		assert.False(t, filter.Excludes(time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)))
+		assert.True(t, filter.Flags(time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)))
+		assert.True(t, filter.Flags(time.Date(2026, 4, 3, 20, 0, 0, 0, time.UTC)))
+		assert.False(t, filter.Flags(time.Date(2026, 6, 4, 20, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("error unknown holiday mode", func(t *testing.T) {
+		_, err := getDateFilter(nil, nil, nil, "DE", "", "ignore", time.UTC)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error unknown holiday country", func(t *testing.T) {
+		_, err := getDateFilter(nil, nil, nil, "XX", "", "", time.UTC)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error holiday subdivision without country", func(t *testing.T) {
+		_, err := getDateFilter(nil, nil, nil, "", "BY", "", time.UTC)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error unknown holiday calendar", func(t *testing.T) {
+		_, err := getDateFilter(nil, nil, []string{"bundled:unknown"}, "", "", "", time.UTC)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error invalid include rule", func(t *testing.T) {
+		_, err := getDateFilter([]string{"BYDAY=FR"}, nil, nil, "", "", "", time.UTC)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("error invalid exclude rule", func(t *testing.T) {
+		_, err := getDateFilter(nil, []string{"FREQ=MONTHLY"}, nil, "", "", "", time.UTC)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetStartTimes(t *testing.T) {
+	parameters := []struct {
+		name               string
+		startTime          string
+		weekdayStartTimes  map[string]string
+		expectedStartTimes poll.StartTimes
+		expectError        bool
+	}{
+		{
+			name:               "default start time",
+			expectedStartTimes: poll.NewStartTimes(poll.DefaultStartTime, map[time.Weekday]poll.TimeOfDay{}),
+		},
+		{
+			name:              "custom start time with weekday overrides",
+			startTime:         "19:30",
+			weekdayStartTimes: map[string]string{"saturday": "14:00", "5": "20:00"},
+			expectedStartTimes: poll.NewStartTimes(poll.TimeOfDay{Hour: 19, Minute: 30}, map[time.Weekday]poll.TimeOfDay{
+				time.Saturday: {Hour: 14},
+				time.Friday:   {Hour: 20},
+			}),
+		},
+		{name: "invalid start time", startTime: "7pm", expectError: true},
+		{name: "invalid weekday override", weekdayStartTimes: map[string]string{"someday": "14:00"}, expectError: true},
+		{name: "invalid weekday start time", weekdayStartTimes: map[string]string{"saturday": "25:00"}, expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			startTimes, err := getStartTimes(parameter.startTime, parameter.weekdayStartTimes)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedStartTimes, startTimes)
+			}
+		})
+	}
+}
+
+func TestGetTieBreaker(t *testing.T) {
+	unityDay := time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)
+	sunday := time.Date(2026, 10, 4, 20, 0, 0, 0, time.UTC)
+	parameters := []struct {
+		name           string
+		request        PollRequest
+		expectedWinner time.Time
+		expectError    bool
+	}{
+		{name: "default", request: PollRequest{}, expectedWinner: unityDay},
+		{name: "weekday order", request: PollRequest{TieBreak: "weekday", TieBreakWeekdays: []Weekday{"sunday"}}, expectedWinner: sunday},
+		{name: "weekday without order", request: PollRequest{TieBreak: "weekday"}, expectError: true},
+		{name: "fewest conflicts with flagged holidays", request: PollRequest{TieBreak: "fewestConflicts", HolidayCountry: "DE", HolidayMode: "flag"}, expectedWinner: sunday},
+		{name: "fewest conflicts with conflict calendar", request: PollRequest{TieBreak: "fewestConflicts", ConflictCalendars: []string{"bundled:de-fixed-holidays"}}, expectedWinner: sunday},
+		{name: "fewest conflicts with excluded holidays", request: PollRequest{TieBreak: "fewestConflicts", HolidayCountry: "DE"}, expectError: true},
+		{name: "fewest conflicts with unknown conflict calendar", request: PollRequest{TieBreak: "fewestConflicts", ConflictCalendars: []string{"bundled:unknown"}}, expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			result := newPollResult([]string{"poll-id"}, []time.Time{sunday, unityDay}, 2, 4, true)
+
+			tieBreaker, err := getTieBreaker(parameter.request, result, time.UTC)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, parameter.expectedWinner, tieBreaker.Break(result.WinningAnswers))
+			}
+		})
+	}
+}
+
+func TestGetVoterMentionMessages(t *testing.T) {
+	winner := time.Date(2026, 11, 6, 20, 0, 0, 0, time.UTC)
+	other := time.Date(2026, 11, 7, 20, 0, 0, 0, time.UTC)
+	request := PollRequest{MentionVoters: true, NotifyOtherVoters: true}
+
+	t.Run("other voters on the winner message", func(t *testing.T) {
+		result := poll.NewDatePollResult(nil, []time.Time{winner}, 1, 2, true)
+		result.Scores = []poll.AnswerScore{{Date: winner, Votes: 1, Voters: []string{"alice"}}, {Date: other, Votes: 1, Voters: []string{"bob"}}}
+
+		messages := getVoterMentionMessages(request, result, winner, []string{"alice"}, "We have a winner!")
+
+		assert.Equal(t, []*message.Message{
+			message.NewUserMentionMessage("<@alice> We have a winner!\n"+fmt.Sprintf(defaultOtherVoters, "<@bob>"), []string{"alice"}),
+		}, messages)
+	})
+
+	t.Run("many other voters within the message limits", func(t *testing.T) {
+		otherVoters := make([]string, 200)
+		for i := range otherVoters {
+			otherVoters[i] = fmt.Sprintf("%019d", i)
+		}
+		result := poll.NewDatePollResult(nil, []time.Time{winner}, 1, 201, true)
+		result.Scores = []poll.AnswerScore{{Date: winner, Votes: 1, Voters: []string{"alice"}}, {Date: other, Votes: 200, Voters: otherVoters}}
+
+		messages := getVoterMentionMessages(request, result, winner, []string{"alice"}, "We have a winner!")
+
+		var mentioned []string
+		for _, voterMessage := range messages {
+			assert.LessOrEqual(t, utf8.RuneCountInString(voterMessage.Content), maxMessageLength)
+			for _, otherVoter := range otherVoters {
+				if strings.Contains(voterMessage.Content, "<@"+otherVoter+">") {
+					mentioned = append(mentioned, otherVoter)
+				}
+			}
+		}
+		assert.Greater(t, len(messages), 2)
+		assert.Equal(t, otherVoters, mentioned)
+		assert.Equal(t, []string{"alice"}, messages[0].MentionUserIDs)
+		for _, voterMessage := range messages[1:] {
+			assert.Empty(t, voterMessage.MentionUserIDs)
+		}
+	})
+}
+
+func TestGetMentionMessages(t *testing.T) {
+	t.Run("mentions in front of the content", func(t *testing.T) {
+		messages := getMentionMessages("testMessage", []string{"alice", "bob"})
+
+		assert.Equal(t, []*message.Message{message.NewUserMentionMessage("<@alice> <@bob> testMessage", []string{"alice", "bob"})}, messages)
+	})
+
+	t.Run("mentions beyond the length limit in further messages", func(t *testing.T) {
+		userIDs := []string{strings.Repeat("a", 1000), strings.Repeat("b", 1000)}
+
+		messages := getMentionMessages("testMessage", userIDs)
+
+		if assert.Len(t, messages, 2) {
+			assert.Equal(t, message.NewUserMentionMessage("<@"+userIDs[0]+"> testMessage", userIDs[:1]), messages[0])
+			assert.Equal(t, message.NewUserMentionMessage("<@"+userIDs[1]+">", userIDs[1:]), messages[1])
+		}
+	})
+
+	t.Run("content without room for mentions", func(t *testing.T) {
+		content := strings.Repeat("x", 1999)
+
+		messages := getMentionMessages(content, []string{"alice"})
+
+		assert.Equal(t, []*message.Message{
+			message.NewUserMentionMessage(content, []string{}),
+			message.NewUserMentionMessage("<@alice>", []string{"alice"}),
+		}, messages)
+	})
+}
+
+func TestFormatVotes(t *testing.T) {
+	parameters := []struct {
+		name     string
+		score    poll.AnswerScore
+		expected string
+	}{
+		{name: "single vote", score: poll.AnswerScore{Votes: 1, Score: 1}, expected: "1 vote"},
+		{name: "multiple votes", score: poll.AnswerScore{Votes: 3, Score: 3}, expected: "3 votes"},
+		{name: "weighted votes", score: poll.AnswerScore{Votes: 3, Score: 4.5}, expected: "3 votes (score 4.5)"},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			assert.Equal(t, parameter.expected, formatVotes(parameter.score))
+		})
+	}
+}
+
+// newPollResult scores every winning answer with its votes, like the plurality strategy does
+func newPollResult(pollIDs []string, winningAnswers []time.Time, winningVotes int, totalVotes int, finalized bool) *poll.DatePollResult {
+	result := poll.NewDatePollResult(pollIDs, winningAnswers, float64(winningVotes), totalVotes, finalized)
+	for _, answer := range winningAnswers {
+		result.Scores = append(result.Scores, poll.AnswerScore{Date: answer, Votes: winningVotes, Score: float64(winningVotes), Eligible: true})
+	}
+	return result
+}
+
+func TestEndPoll(t *testing.T) {
+	pollChannelID := "poll-channel-id"
+	announcementChannelID := "announcement-channel-id"
+	messageID := "message-id"
+	pollID := "poll-id"
+
+	t.Run("successful poll end", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+		}
+		winning := []time.Time{time.Unix(3000, 0).UTC(), time.Unix(2000, 0).UTC()}
+		result := newPollResult([]string{pollID}, winning, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) && assert.NotNil(t, response.Date) {
+			assert.Equal(t, OutcomeWinner, response.Outcome)
+			assert.Equal(t, time.Unix(2000, 0).UTC(), *response.Date)
+		}
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("no date found announcement below minimum votes", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		minVotes := 4
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			MinVotes:              &minVotes,
+			NoDateMessage:         "No date this time",
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("SendMessage", announcementChannelID, message.NewMessage("No date this time", true)).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeNoDate, response.Outcome)
+			assert.Nil(t, response.Date)
+		}
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+	})
+
+	t.Run("no date found without any votes", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		result := newPollResult([]string{pollID}, nil, 0, 0, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("SendMessage", announcementChannelID, message.NewMessage(defaultNoDateMessage, true)).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeNoDate, response.Outcome)
+		}
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+	})
+
+	t.Run("error follow-up poll that would close after more than 32 days", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Quorum:                10,
+			NoDateAction:          "followUp",
+			FollowUpDurationHours: 800,
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Date(2026, 12, 9, 20, 0, 0, 0, time.UTC)}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(result, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.ErrorContains(t, err, "more than 32 days from now")
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("follow-up poll when quorum is not reached", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		bot.now = func() time.Time { return now }
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Quorum:                10,
+			NoDateAction:          "followUp",
+		}
+		past := time.Date(2026, 10, 2, 20, 0, 0, 0, time.UTC)
+		upcoming := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+		result := newPollResult([]string{pollID}, []time.Time{upcoming}, 3, 5, true)
+		result.Question = "Poll for October 2026"
+		result.Answers = []time.Time{past, upcoming}
+		followUpPoll := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			return !datePoll.Runoff &&
+				datePoll.Question == "Poll for October 2026" &&
+				assert.ObjectsAreEqual([]time.Time{upcoming}, datePoll.Answers) &&
+				datePoll.Expiry.Equal(now.Add(48*time.Hour+time.Minute))
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(result, nil)
+		mockService.On("SendPoll", pollChannelID, followUpPoll).Return("follow-up-id", nil)
+		mockService.On("PinPoll", pollChannelID, "follow-up-id").Return(nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeFollowUp, response.Outcome)
+		}
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll end with approval strategy", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ResultStrategy:        "approval",
+			ApprovalThreshold:     3,
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.ApprovalStrategy{Threshold: 3}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll end with required role and members", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ResultStrategy:        "approval",
+			ApprovalThreshold:     2,
+			RequiredMembers:       []string{"game-master"},
+			RequiredRole:          "core-role",
+			GuildID:               "guild-id",
+		}
+		expectedStrategy := poll.RequiredMembersStrategy{
+			Members:  []string{"game-master", "alice", "bob"},
+			Strategy: poll.ApprovalStrategy{Threshold: 2},
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetRoleMembers", "guild-id", "core-role").Return([]string{"alice", "bob"}, nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), expectedStrategy, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll end with role weights and eligible roles", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ResultStrategy:        "roleWeighted",
+			RoleWeights:           map[string]float64{"core-role": 2},
+			EligibleRoles:         []string{"member-role"},
+			GuildID:               "guild-id",
+		}
+		memberRoles := map[string][]string{"alice": {"core-role", "member-role"}, "bob": {"member-role"}}
+		expectedStrategy := poll.EligibleVotersStrategy{
+			Roles:       []string{"member-role"},
+			MemberRoles: memberRoles,
+			Strategy:    poll.RoleWeightedStrategy{Weights: map[string]float64{"core-role": 2}, DefaultWeight: 1, MemberRoles: memberRoles},
+		}
+		result := poll.NewDatePollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 2, true)
+		result.Scores = []poll.AnswerScore{{Date: time.Unix(2000, 0).UTC(), Votes: 2, Score: 3, Eligible: true}}
+		mockService.On("Open").Return(nil)
+		mockService.On("GetMemberRoles", "guild-id").Return(memberRoles, nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), expectedStrategy, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll end with role weights below one", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ResultStrategy:        "roleWeighted",
+			RoleWeights:           map[string]float64{"guest-role": 0.5},
+			GuildID:               "guild-id",
+		}
+		memberRoles := map[string][]string{"alice": {"guest-role"}}
+		expectedStrategy := poll.RoleWeightedStrategy{Weights: map[string]float64{"guest-role": 0.5}, DefaultWeight: 1, MemberRoles: memberRoles}
+		result := poll.NewDatePollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 0.5, 1, true)
+		result.Scores = []poll.AnswerScore{{Date: time.Unix(2000, 0).UTC(), Votes: 1, Score: 0.5, Eligible: true}}
+		mockService.On("Open").Return(nil)
+		mockService.On("GetMemberRoles", "guild-id").Return(memberRoles, nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), expectedStrategy, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeWinner, response.Outcome)
+		}
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("error role weights without guild", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", ResultStrategy: "roleWeighted", RoleWeights: map[string]float64{"core-role": 2}}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetMemberRoles")
+		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
+	})
+
+	t.Run("error required role without guild", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", RequiredRole: "core-role"}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetRoleMembers")
+		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
+	})
+
+	t.Run("error unknown result strategy", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", ResultStrategy: "condorcet"}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
+	})
+
+	t.Run("error unknown no date action", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", NoDateAction: "ignore"}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("successful poll end with tie break", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			TieBreak:              "latest",
+		}
+		winning := []time.Time{time.Unix(3000, 0).UTC(), time.Unix(2000, 0).UTC()}
+		result := newPollResult([]string{pollID}, winning, 3, 5, true)
+		tieAnnouncement := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "<t:3000:F>") &&
+				strings.Contains(announcement.Content, "It was a tie with <t:2000:F>, decided by latest date.")
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, tieAnnouncement).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful forced poll end of open poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		var sleeps []time.Duration
+		bot.sleep = func(delay time.Duration) { sleeps = append(sleeps, delay) }
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Force:                 true,
+		}
+		openResult := newPollResult([]string{pollID, "poll-id-2"}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, false)
+		countingResult := newPollResult([]string{pollID, "poll-id-2"}, []time.Time{time.Unix(2000, 0).UTC()}, 4, 6, false)
+		closedResult := newPollResult([]string{pollID, "poll-id-2"}, []time.Time{time.Unix(3000, 0).UTC()}, 4, 6, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(openResult, nil).Once()
+		mockService.On("ExpirePoll", pollChannelID, pollID).Return(nil)
+		mockService.On("ExpirePoll", pollChannelID, "poll-id-2").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(countingResult, nil).Once()
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(closedResult, nil).Once()
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("UnpinPoll", pollChannelID, "poll-id-2").Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "<t:3000:F>")
+		})).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeWinner, response.Outcome)
+			assert.Equal(t, time.Unix(3000, 0).UTC(), *response.Date)
+		}
+		assert.Equal(t, []time.Duration{finalizeDelay}, sleeps)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("error closed poll that is not finalized in time", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.sleep = func(time.Duration) {}
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", Force: true}
+		openResult := newPollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, false)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(openResult, nil)
+		mockService.On("ExpirePoll", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		mockService.AssertNumberOfCalls(t, "GetLastPinnedPollResult", 1+finalizeAttempts)
+		mockService.AssertNotCalled(t, "UnpinPoll", mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error invalid request does not close open poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", Force: true, TieBreak: "randon"}
+		openResult := newPollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, false)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(openResult, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "ExpirePoll", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error expiring open poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Force:                 true,
+		}
+		expectedErr := errors.New("expire error")
+		openResult := newPollResult([]string{pollID}, []time.Time{time.Unix(2000, 0).UTC()}, 3, 5, false)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(openResult, nil)
+		mockService.On("ExpirePoll", pollChannelID, pollID).Return(expectedErr)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, response)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("successful poll end with scheduled event", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			GuildID:               "guild-id",
+			CreateEvent:           true,
+			EventDescription:      "Bring snacks!",
+			EventChannelID:        "voice-channel-id",
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 3, 5, true)
+		result.Question = "Poll for November 2025"
+		expectedEvent := event.NewEvent("Poll for November 2025", "Bring snacks!", time.Unix(3000, 0).UTC(), 3*time.Hour, "voice-channel-id", "")
+		eventAnnouncement := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.HasSuffix(announcement.Content, "\n:calendar_spiral: Click interested to get reminded: https://discord.com/events/guild-id/event-id")
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("CreateEvent", "guild-id", expectedEvent).Return("event-id", nil)
+		mockService.On("SendMessage", announcementChannelID, eventAnnouncement).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll end without scheduled event after error creating it", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			GuildID:               "guild-id",
+			CreateEvent:           true,
+			EventName:             "Game Night",
+			EventDurationHours:    5,
+			EventLocation:         "Game Store",
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 3, 5, true)
+		expectedEvent := event.NewEvent("Game Night", "", time.Unix(3000, 0).UTC(), 5*time.Hour, "", "Game Store")
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("CreateEvent", "guild-id", expectedEvent).Return("", errors.New("event error"))
+		mockService.On("SendMessage", announcementChannelID, mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "<t:3000:F>") && !strings.Contains(announcement.Content, "discord.com/events")
+		})).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeWinner, response.Outcome)
+		}
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "PinPoll")
+	})
+
+	t.Run("rollback keeps missing scheduled event after error during send message", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			FailureMode:           "rollback",
+			GuildID:               "guild-id",
+			CreateEvent:           true,
+			EventLocation:         "Game Store",
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("CreateEvent", "guild-id", mock.AnythingOfType("*event.Event")).Return("", errors.New("event error"))
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Equal(t, assert.AnError, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "DeleteEvent")
+	})
+
+	t.Run("error scheduled event without location", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", GuildID: "guild-id", CreateEvent: true}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "CreateEvent")
+	})
+
+	t.Run("successful poll end with tally", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ShowTally:             true,
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 4, 7, true)
+		result.Scores = []poll.AnswerScore{
+			{Date: time.Unix(2000, 0).UTC(), Votes: 2, Score: 2, Eligible: true},
+			{Date: time.Unix(3000, 0).UTC(), Votes: 4, Score: 4, Eligible: true},
+			{Date: time.Unix(4000, 0).UTC(), Votes: 1, Score: 1, Eligible: true},
+		}
+		tallyAnnouncement := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.HasSuffix(announcement.Content, "\n\n**Results** (7 votes)\n"+
+				"`█████░░░░░` 2 votes <t:2000:F>\n"+
+				"`██████████` 4 votes <t:3000:F> :trophy:\n"+
+				"`███░░░░░░░` 1 vote <t:4000:F>\n"+
+				"-# Runner-up was <t:2000:F> with 2 votes.")
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, tallyAnnouncement).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful poll end mentioning voters", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			MentionVoters:         true,
+			NotifyOtherVoters:     true,
+		}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 2, 3, true)
+		result.Scores = []poll.AnswerScore{
+			{Date: time.Unix(2000, 0).UTC(), Votes: 1, Score: 1, Eligible: true, Voters: []string{"carol"}},
+			{Date: time.Unix(3000, 0).UTC(), Votes: 2, Score: 2, Eligible: true, Voters: []string{"alice", "bob"}},
+		}
+		expectedAnnouncement := message.NewUserMentionMessage(
+			"<@alice> <@bob> We have a winner :trophy:! The next event happens on <t:3000:F> :calendar:. See you then!\n"+
+				"-# <@carol>, your dates did not win this time, hope you can make it anyway!",
+			[]string{"alice", "bob"})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, expectedAnnouncement).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful runoff start on tie", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		bot.now = func() time.Time { return now }
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Runoff:                true,
+			RunoffDurationHours:   12,
+		}
+		tied := []time.Time{time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC), time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)}
+		result := newPollResult([]string{pollID}, tied, 3, 5, true)
+		result.Question = "Poll for October 2026"
+		runoffPoll := mock.MatchedBy(func(datePoll *poll.DatePoll) bool {
+			return datePoll.Runoff &&
+				datePoll.Question == "Runoff: Poll for October 2026" &&
+				assert.ObjectsAreEqual([]time.Time{tied[1], tied[0]}, datePoll.Answers) &&
+				datePoll.Expiry.Equal(now.Add(12*time.Hour+time.Minute))
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("SendPoll", pollChannelID, runoffPoll).Return("runoff-id", nil)
+		mockService.On("PinPoll", pollChannelID, "runoff-id").Return(nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "runoff poll")
+		})).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) {
+			assert.Equal(t, OutcomeRunoff, response.Outcome)
+		}
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful runoff end announces winner", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Runoff:                true,
+		}
+		result := newPollResult([]string{"runoff-id"}, []time.Time{time.Unix(3000, 0).UTC(), time.Unix(2000, 0).UTC()}, 3, 5, true)
+		result.Runoff = true
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, "runoff-id").Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, "<t:2000:F>")
+		})).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("tie breaker decides when tied dates pass before the runoff closes", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 31, 13, 0, 0, 0, time.UTC) }
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			Runoff:                true,
+			TieBreak:              "latest",
+		}
+		tied := []time.Time{time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC), time.Date(2026, 11, 3, 20, 0, 0, 0, time.UTC)}
+		result := newPollResult([]string{pollID}, tied, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.Contains(announcement.Content, fmt.Sprintf("<t:%d:F>", tied[1].Unix()))
+		})).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, response) && assert.NotNil(t, response.Date) {
+			assert.Equal(t, OutcomeWinner, response.Outcome)
+			assert.Equal(t, tied[1], *response.Date)
+		}
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+	})
+
+	t.Run("error runoff that would close after more than 32 days", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", Runoff: true, RunoffDurationHours: 800}
+		tied := []time.Time{time.Date(2026, 12, 10, 20, 0, 0, 0, time.UTC), time.Date(2026, 12, 9, 20, 0, 0, 0, time.UTC)}
+		result := newPollResult([]string{pollID}, tied, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.ErrorContains(t, err, "more than 32 days from now")
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendPoll")
+		mockService.AssertNotCalled(t, "UnpinPoll")
+	})
+
+	t.Run("error during runoff send poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		bot.now = func() time.Time { return time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC) }
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", Runoff: true}
+		tied := []time.Time{time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC), time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)}
+		result := newPollResult([]string{pollID}, tied, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("SendPoll", pollChannelID, mock.AnythingOfType("*poll.DatePoll")).Return("", assert.AnError)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("error invalid tie break", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", TieBreak: "coinFlip"}
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("successful poll end across poll parts", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		result := newPollResult([]string{"poll-id-1", "poll-id-2"}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(result, nil)
+		mockService.On("UnpinPoll", pollChannelID, "poll-id-1").Return(nil)
+		mockService.On("UnpinPoll", pollChannelID, "poll-id-2").Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("error during open", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		mockService.On("Open").Return(assert.AnError)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+		mockService.AssertNotCalled(t, "Close")
+	})
+
+	t.Run("error invalid timezone", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "Invalid/Zone"}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("error get last pinned poll result", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return((*poll.DatePollResult)(nil), assert.AnError)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("error poll not finalized", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, false)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
+		mockService.On("Close").Return(nil)
+
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "UnpinPoll")
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("error during unpin poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
 		mockService.On("UnpinPoll", pollChannelID, pollID).Return(assert.AnError)
 		mockService.On("Close").Return(nil)
 
-		err := bot.EndPoll(request)
+		_, err := bot.EndPoll(request)
 
 		assert.Error(t, err)
 		mockService.AssertExpectations(t)
@@ -337,31 +2279,98 @@ func TestEndPoll(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
 		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
-		res := poll.NewDatePollResult(pollID, []time.Time{time.Unix(1000, 0).UTC()}, true)
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
 		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return(res, nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
 		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
 		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
 		mockService.On("Close").Return(nil)
 
-		err := bot.EndPoll(request)
+		_, err := bot.EndPoll(request)
 
 		assert.Error(t, err)
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("rollback pins poll again after error during send message", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", FailureMode: "rollback"}
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Nil(t, response)
+		assert.Equal(t, assert.AnError, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("rollback deletes event after error during send message", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "endPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			FailureMode:           "rollback",
+			GuildID:               "guild-id",
+			CreateEvent:           true,
+			EventChannelID:        "voice-channel-id",
+		}
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("CreateEvent", "guild-id", mock.AnythingOfType("*event.Event")).Return("event-id", nil)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", assert.AnError)
+		mockService.On("DeleteEvent", "guild-id", "event-id").Return(nil)
+		mockService.On("PinPoll", pollChannelID, pollID).Return(nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Nil(t, response)
+		assert.Equal(t, assert.AnError, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("continue announces winner after error during unpin poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", FailureMode: "continue"}
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
+		mockService.On("UnpinPoll", pollChannelID, pollID).Return(assert.AnError)
+		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		response, err := bot.EndPoll(request)
+
+		assert.Nil(t, response)
+		assert.Equal(t, assert.AnError, err)
+		mockService.AssertExpectations(t)
+	})
+
 	t.Run("error during close", func(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
 		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
-		res := poll.NewDatePollResult(pollID, []time.Time{time.Unix(1000, 0).UTC()}, true)
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
 		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return(res, nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
 		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
 		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return(messageID, nil)
 		mockService.On("Close").Return(assert.AnError)
 
-		err := bot.EndPoll(request)
+		_, err := bot.EndPoll(request)
 
 		assert.Error(t, err)
 		mockService.AssertExpectations(t)
@@ -371,19 +2380,173 @@ func TestEndPoll(t *testing.T) {
 		mockService := new(MockService)
 		bot := NewBot(mockService)
 		request := PollRequest{Action: "endPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
-		res := poll.NewDatePollResult(pollID, []time.Time{time.Unix(1000, 0).UTC()}, true)
+		res := newPollResult([]string{pollID}, []time.Time{time.Unix(1000, 0).UTC()}, 3, 5, true)
 		expectedErr := errors.New("some error")
 		closeErr := errors.New("error during close")
 		mockService.On("Open").Return(nil)
-		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location")).Return(res, nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, false).Return(res, nil)
 		mockService.On("UnpinPoll", pollChannelID, pollID).Return(nil)
 		mockService.On("SendMessage", announcementChannelID, mock.AnythingOfType("*message.Message")).Return("", expectedErr)
 		mockService.On("Close").Return(closeErr)
 
-		err := bot.EndPoll(request)
+		_, err := bot.EndPoll(request)
+
+		assert.Error(t, err)
+		assert.Equal(t, expectedErr, err)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestRemindPoll(t *testing.T) {
+	pollChannelID := "poll-channel-id"
+	announcementChannelID := "announcement-channel-id"
+	messageID := "message-id"
+	pollID := "poll-id"
+	openResult := func() *poll.DatePollResult {
+		result := newPollResult([]string{pollID}, []time.Time{time.Unix(3000, 0).UTC()}, 2, 3, false)
+		result.Scores = []poll.AnswerScore{
+			{Date: time.Unix(2000, 0).UTC(), Votes: 1, Score: 1, Eligible: true, Voters: []string{"alice"}},
+			{Date: time.Unix(3000, 0).UTC(), Votes: 2, Score: 2, Eligible: true, Voters: []string{"alice", "bob"}},
+		}
+		result.Expiry = time.Unix(9000, 0).UTC()
+		return result
+	}
+
+	t.Run("successful reminder of role members without votes", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "remindPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ReminderRole:          "member-role",
+			GuildID:               "guild-id",
+		}
+		reminder := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.HasPrefix(announcement.Content, "<@carol> <@dave> :alarm_clock: Reminder! The poll for the next event closes <t:9000:R>") &&
+				strings.Contains(announcement.Content, "\n\n**Results** (3 votes)\n") &&
+				strings.Contains(announcement.Content, "2 votes <t:3000:F> :trophy:") &&
+				!announcement.MentionsEveryone &&
+				assert.ObjectsAreEqual([]string{"carol", "dave"}, announcement.MentionUserIDs)
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(openResult(), nil)
+		mockService.On("GetRoleMembers", "guild-id", "member-role").Return([]string{"alice", "carol", "bob", "dave"}, nil)
+		mockService.On("SendMessage", announcementChannelID, reminder).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.RemindPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("successful reminder of many role members split across messages", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{
+			Action:                "remindPoll",
+			PollChannelID:         pollChannelID,
+			AnnouncementChannelID: announcementChannelID,
+			TimeZone:              "UTC",
+			ReminderRole:          "member-role",
+			GuildID:               "guild-id",
+		}
+		members := make([]string, 150)
+		for i := range members {
+			members[i] = fmt.Sprintf("member-%03d", i)
+		}
+		firstReminder := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.HasPrefix(announcement.Content, "<@member-000> ") &&
+				strings.Contains(announcement.Content, ":alarm_clock: Reminder!") &&
+				assert.ObjectsAreEqual(members[:100], announcement.MentionUserIDs)
+		})
+		secondReminder := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.HasPrefix(announcement.Content, "<@member-100> ") &&
+				!strings.Contains(announcement.Content, ":alarm_clock: Reminder!") &&
+				assert.ObjectsAreEqual(members[100:], announcement.MentionUserIDs)
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(openResult(), nil)
+		mockService.On("GetRoleMembers", "guild-id", "member-role").Return(members, nil)
+		mockService.On("SendMessage", announcementChannelID, firstReminder).Return(messageID, nil).Once()
+		mockService.On("SendMessage", announcementChannelID, secondReminder).Return(messageID, nil).Once()
+		mockService.On("Close").Return(nil)
+
+		err := bot.RemindPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNumberOfCalls(t, "SendMessage", 2)
+	})
+
+	t.Run("successful reminder of everyone without role", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "remindPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		result := openResult()
+		result.Expiry = time.Time{}
+		reminder := mock.MatchedBy(func(announcement *message.Message) bool {
+			return strings.HasPrefix(announcement.Content, "@here :alarm_clock: Reminder! The poll for the next event closes soon") && announcement.MentionsEveryone
+		})
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(result, nil)
+		mockService.On("SendMessage", announcementChannelID, reminder).Return(messageID, nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.RemindPoll(request)
+
+		assert.NoError(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetRoleMembers")
+	})
+
+	t.Run("error reminder for finalized poll", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "remindPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC"}
+		result := openResult()
+		result.Finalized = true
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(result, nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.RemindPoll(request)
+
+		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendMessage")
+	})
+
+	t.Run("error reminder role without guild", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "remindPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", ReminderRole: "member-role"}
+		mockService.On("Open").Return(nil)
+		mockService.On("Close").Return(nil)
+
+		err := bot.RemindPoll(request)
 
 		assert.Error(t, err)
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetLastPinnedPollResult")
+	})
+
+	t.Run("error retrieving role members", func(t *testing.T) {
+		mockService := new(MockService)
+		bot := NewBot(mockService)
+		request := PollRequest{Action: "remindPoll", PollChannelID: pollChannelID, AnnouncementChannelID: announcementChannelID, TimeZone: "UTC", ReminderRole: "member-role", GuildID: "guild-id"}
+		expectedErr := errors.New("members error")
+		mockService.On("Open").Return(nil)
+		mockService.On("GetLastPinnedPollResult", pollChannelID, mock.AnythingOfType("*time.Location"), poll.PluralityStrategy{}, true).Return(openResult(), nil)
+		mockService.On("GetRoleMembers", "guild-id", "member-role").Return(nil, expectedErr)
+		mockService.On("Close").Return(nil)
+
+		err := bot.RemindPoll(request)
+
 		assert.Equal(t, expectedErr, err)
 		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "SendMessage")
 	})
 }
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+)
+
+const (
+	failureModeAbort    = "abort"
+	failureModeRollback = "rollback"
+	failureModeContinue = "continue"
+)
+
+type step struct {
+	name       string
+	run        func() error
+	compensate func() error
+}
+
+// stepRunner stops at the first failed step by default, rollback undoes the completed steps
+// and continue runs the remaining steps anyway
+type stepRunner struct {
+	failureMode string
+	completed   []step
+	errs        []error
+}
+
+func newStepRunner(failureMode string) (*stepRunner, error) {
+	switch failureMode {
+	case "", failureModeAbort, failureModeRollback, failureModeContinue:
+		return &stepRunner{failureMode: failureMode}, nil
+	default:
+		return nil, fmt.Errorf("unknown failure mode: %s", failureMode)
+	}
+}
+
+func (r *stepRunner) run(s step) {
+	if r.failed() && r.failureMode != failureModeContinue {
+		return
+	}
+	err := s.run()
+	if err != nil {
+		log.Printf("step '%s' failed: %v", s.name, err)
+		r.errs = append(r.errs, err)
+		if r.failureMode == failureModeRollback {
+			r.rollback()
+		}
+		return
+	}
+	r.completed = append(r.completed, s)
+}
+
+func (r *stepRunner) rollback() {
+	for i := len(r.completed) - 1; i >= 0; i-- {
+		completed := r.completed[i]
+		if completed.compensate == nil {
+			continue
+		}
+		err := completed.compensate()
+		if err != nil {
+			log.Printf("could not roll back step '%s': %v", completed.name, err)
+			r.errs = append(r.errs, fmt.Errorf("could not roll back step '%s': %w", completed.name, err))
+			continue
+		}
+		log.Printf("successfully rolled back step '%s'", completed.name)
+	}
+	r.completed = nil
+}
+
+func (r *stepRunner) failed() bool {
+	return len(r.errs) > 0
+}
+
+// err keeps a single error as it is, so that callers can still compare it
+func (r *stepRunner) err() error {
+	if len(r.errs) == 1 {
+		return r.errs[0]
+	}
+	return errors.Join(r.errs...)
+}
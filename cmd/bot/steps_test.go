@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepRunner(t *testing.T) {
+	stepErr := errors.New("step error")
+	compensateErr := errors.New("compensate error")
+	parameters := []struct {
+		name          string
+		failureMode   string
+		expectedCalls []string
+		expectedErrs  []error
+	}{
+		{
+			name:          "abort",
+			failureMode:   "",
+			expectedCalls: []string{"run first", "run second", "run failing"},
+			expectedErrs:  []error{stepErr},
+		},
+		{
+			name:          "rollback",
+			failureMode:   "rollback",
+			expectedCalls: []string{"run first", "run second", "run failing", "compensate second", "compensate first"},
+			expectedErrs:  []error{stepErr, compensateErr},
+		},
+		{
+			name:          "continue",
+			failureMode:   "continue",
+			expectedCalls: []string{"run first", "run second", "run failing", "run last"},
+			expectedErrs:  []error{stepErr},
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			var calls []string
+			record := func(call string, err error) func() error {
+				return func() error {
+					calls = append(calls, call)
+					return err
+				}
+			}
+			runner, err := newStepRunner(parameter.failureMode)
+			assert.NoError(t, err)
+
+			runner.run(step{name: "first", run: record("run first", nil), compensate: record("compensate first", nil)})
+			runner.run(step{name: "second", run: record("run second", nil), compensate: record("compensate second", compensateErr)})
+			runner.run(step{name: "failing", run: record("run failing", stepErr), compensate: record("compensate failing", nil)})
+			runner.run(step{name: "last", run: record("run last", nil)})
+
+			assert.Equal(t, parameter.expectedCalls, calls)
+			assert.True(t, runner.failed())
+			for _, expectedErr := range parameter.expectedErrs {
+				assert.ErrorIs(t, runner.err(), expectedErr)
+			}
+		})
+	}
+}
+
+func TestStepRunner_Err(t *testing.T) {
+	runner, _ := newStepRunner("continue")
+	assert.False(t, runner.failed())
+	assert.NoError(t, runner.err())
+
+	runner.run(step{name: "failing", run: func() error { return assert.AnError }})
+	assert.Equal(t, assert.AnError, runner.err())
+}
+
+func TestNewStepRunner(t *testing.T) {
+	_, err := newStepRunner("retry")
+
+	assert.EqualError(t, err, "unknown failure mode: retry")
+}
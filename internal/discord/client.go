@@ -1,6 +1,11 @@
 package discord
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -10,7 +15,15 @@ type Client interface {
 	ChannelMessageSend(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error)
 	ChannelMessagePin(channelID string, messageID string) error
 	ChannelMessageUnpin(channelID string, messageID string) error
+	ChannelMessageDelete(channelID string, messageID string) error
 	ChannelMessagesPinned(channelID string) ([]*discordgo.Message, error)
+	ChannelMessages(channelID string, limit int) ([]*discordgo.Message, error)
+	MessageReactionAdd(channelID string, messageID string, emoji string) error
+	PollAnswerVoters(channelID string, messageID string, answerID int, after string, limit int) ([]*discordgo.User, error)
+	GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error)
+	ExpirePoll(channelID string, messageID string) error
+	GuildScheduledEventCreate(guildID string, params *discordgo.GuildScheduledEventParams) (*discordgo.GuildScheduledEvent, error)
+	GuildScheduledEventDelete(guildID string, eventID string) error
 }
 
 type DefaultClient struct {
@@ -22,6 +35,8 @@ func NewDefaultClient(token string) (*DefaultClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	// rate limits are returned as errors, so that they are retried within the deadline of the request
+	session.ShouldRetryOnRateLimit = false
 	return &DefaultClient{session: session}, nil
 }
 
@@ -33,6 +48,27 @@ func (c *DefaultClient) Close() error {
 	return c.session.Close()
 }
 
+// RestClient only talks to the rest api, so no gateway websocket is opened for a few requests
+type RestClient struct {
+	*DefaultClient
+}
+
+func NewRestClient(token string) (*RestClient, error) {
+	client, err := NewDefaultClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return &RestClient{DefaultClient: client}, nil
+}
+
+func (c *RestClient) Open() error {
+	return nil
+}
+
+func (c *RestClient) Close() error {
+	return nil
+}
+
 func (c *DefaultClient) ChannelMessageSend(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
 	return c.session.ChannelMessageSendComplex(channelID, data)
 }
@@ -45,6 +81,63 @@ func (c *DefaultClient) ChannelMessageUnpin(channelID string, messageID string)
 	return c.session.ChannelMessageUnpin(channelID, messageID)
 }
 
+func (c *DefaultClient) ChannelMessageDelete(channelID string, messageID string) error {
+	return c.session.ChannelMessageDelete(channelID, messageID)
+}
+
 func (c *DefaultClient) ChannelMessagesPinned(channelID string) ([]*discordgo.Message, error) {
 	return c.session.ChannelMessagesPinned(channelID)
 }
+
+func (c *DefaultClient) ChannelMessages(channelID string, limit int) ([]*discordgo.Message, error) {
+	return c.session.ChannelMessages(channelID, limit, "", "", "")
+}
+
+func (c *DefaultClient) MessageReactionAdd(channelID string, messageID string, emoji string) error {
+	return c.session.MessageReactionAdd(channelID, messageID, emoji)
+}
+
+func (c *DefaultClient) PollAnswerVoters(channelID string, messageID string, answerID int, after string, limit int) ([]*discordgo.User, error) {
+	// discordgo only fetches the first page of voters, so the endpoint is queried directly
+	endpoint := discordgo.EndpointPollAnswerVoters(channelID, messageID, answerID)
+	query := url.Values{}
+	if after != "" {
+		query.Set("after", after)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	uri := endpoint
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+	body, err := c.session.RequestWithBucketID(http.MethodGet, uri, nil, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	var page struct {
+		Users []*discordgo.User `json:"users"`
+	}
+	err = json.Unmarshal(body, &page)
+	if err != nil {
+		return nil, err
+	}
+	return page.Users, nil
+}
+
+func (c *DefaultClient) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
+	return c.session.GuildMembers(guildID, after, limit)
+}
+
+func (c *DefaultClient) ExpirePoll(channelID string, messageID string) error {
+	_, err := c.session.PollExpire(channelID, messageID)
+	return err
+}
+
+func (c *DefaultClient) GuildScheduledEventCreate(guildID string, params *discordgo.GuildScheduledEventParams) (*discordgo.GuildScheduledEvent, error) {
+	return c.session.GuildScheduledEventCreate(guildID, params)
+}
+
+func (c *DefaultClient) GuildScheduledEventDelete(guildID string, eventID string) error {
+	return c.session.GuildScheduledEventDelete(guildID, eventID)
+}
@@ -3,10 +3,12 @@ package discord
 import (
 	"fmt"
 	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/klauspost/lctime"
+	"github.com/paschi/discord-date-decider/internal/event"
 	"github.com/paschi/discord-date-decider/internal/message"
 	"github.com/paschi/discord-date-decider/internal/poll"
 )
@@ -18,77 +20,180 @@ func toDiscordMessage(message *message.Message) *discordgo.MessageSend {
 			Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeEveryone},
 		}
 	}
+	// an empty list of users keeps all mentions in the content silent
+	if message.MentionUserIDs != nil {
+		if allowedMentions == nil {
+			allowedMentions = &discordgo.MessageAllowedMentions{}
+		}
+		allowedMentions.Users = message.MentionUserIDs
+	}
 	return &discordgo.MessageSend{
 		Content:         message.Content,
 		AllowedMentions: allowedMentions,
 	}
 }
 
+func toDiscordScheduledEvent(event *event.Event) *discordgo.GuildScheduledEventParams {
+	params := &discordgo.GuildScheduledEventParams{
+		Name:               event.Name,
+		Description:        event.Description,
+		ScheduledStartTime: &event.Start,
+		ScheduledEndTime:   &event.End,
+		PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+		EntityType:         discordgo.GuildScheduledEventEntityTypeVoice,
+		ChannelID:          event.ChannelID,
+	}
+	if event.IsExternal() {
+		params.EntityType = discordgo.GuildScheduledEventEntityTypeExternal
+		params.EntityMetadata = &discordgo.GuildScheduledEventEntityMetadata{Location: event.Location}
+	}
+	return params
+}
+
+// isPollEnded reports polls that no longer take votes, an ended poll cannot be resumed
+func isPollEnded(discordPoll *discordgo.Poll) bool {
+	if discordPoll.Results != nil && discordPoll.Results.Finalized {
+		return true
+	}
+	return discordPoll.Expiry != nil && discordPoll.Expiry.Before(time.Now())
+}
+
+func toPostedPoll(discordMessage *discordgo.Message, metadata pollMetadata, pinned bool) poll.PostedPoll {
+	announced := slices.ContainsFunc(discordMessage.Reactions, func(reaction *discordgo.MessageReactions) bool {
+		return reaction.Me && reaction.Emoji != nil && reaction.Emoji.Name == announcedReaction
+	})
+	return poll.PostedPoll{
+		ID:        discordMessage.ID,
+		GroupID:   metadata.GroupID,
+		Part:      metadata.Part,
+		Pinned:    pinned,
+		Announced: announced,
+	}
+}
+
 func toDiscordPollMessage(poll *poll.DatePoll) (*discordgo.MessageSend, error) {
 	if time.Now().After(poll.Expiry) {
 		return nil, fmt.Errorf("poll is already expired")
 	}
+	if err := poll.ValidateLabels(); err != nil {
+		return nil, fmt.Errorf("invalid poll answers: %w", err)
+	}
 	hoursUntilExpiry := int(math.Floor(poll.Expiry.Sub(time.Now()).Hours()))
+	metadata := pollMetadata{GroupID: poll.GroupID, Part: poll.Part, Parts: poll.Parts, Period: poll.Period, Profile: poll.Profile, Answers: poll.Answers}
+	if poll.Runoff {
+		metadata.Kind = pollKindRunoff
+	}
 	return &discordgo.MessageSend{
+		Content: metadata.String(),
 		Poll: &discordgo.Poll{
 			Question:         discordgo.PollMedia{Text: poll.Question},
-			Answers:          toDiscordAnswers(poll.Answers),
+			Answers:          toDiscordAnswers(poll),
 			AllowMultiselect: true,
 			Duration:         hoursUntilExpiry,
 		},
 	}, nil
 }
 
-func toDiscordAnswers(answers []time.Time) []discordgo.PollAnswer {
+func toDiscordAnswers(datePoll *poll.DatePoll) []discordgo.PollAnswer {
 	var discordAnswers []discordgo.PollAnswer
-	for _, answer := range answers {
-		discordAnswers = append(discordAnswers, discordgo.PollAnswer{Media: &discordgo.PollMedia{
-			Text: fmt.Sprintf("%s, %02d.%02d.%d", lctime.Strftime("%A", answer), answer.Day(), answer.Month(), answer.Year()),
-		}})
+	for _, answer := range datePoll.Answers {
+		discordAnswers = append(discordAnswers, discordgo.PollAnswer{Media: &discordgo.PollMedia{Text: datePoll.Label(answer)}})
 	}
 	return discordAnswers
 }
 
-func toDatePollResult(discordMessage *discordgo.Message, location *time.Location) (*poll.DatePollResult, error) {
-	discordPoll := discordMessage.Poll
-	if discordPoll == nil {
+func toDatePollResult(discordMessages []*discordgo.Message, location *time.Location, strategy poll.ResultStrategy, voters pollVoters) (*poll.DatePollResult, error) {
+	if len(discordMessages) == 0 {
 		return nil, fmt.Errorf("no poll message")
 	}
-	var highestCount int
-	var winningAnswerIDs []int
-	for i, answerCount := range discordPoll.Results.AnswerCounts {
-		if i == 0 || answerCount.Count > highestCount {
-			highestCount = answerCount.Count
-			winningAnswerIDs = nil
+	var pollIDs []string
+	var answers []time.Time
+	var answerVotes []poll.AnswerVotes
+	var expiry time.Time
+	finalized := true
+	firstMetadata := parsePollMetadata(discordMessages[0].Content)
+	for _, discordMessage := range discordMessages {
+		discordPoll := discordMessage.Poll
+		if discordPoll == nil {
+			return nil, fmt.Errorf("no poll message")
 		}
-		if highestCount == answerCount.Count {
-			winningAnswerIDs = append(winningAnswerIDs, answerCount.ID)
+		pollIDs = append(pollIDs, discordMessage.ID)
+		if discordPoll.Expiry != nil && discordPoll.Expiry.After(expiry) {
+			expiry = *discordPoll.Expiry
 		}
-	}
-	if len(winningAnswerIDs) == 0 {
-		return nil, fmt.Errorf("could not find a winning answer for this poll: %+v, %+v, %+v, %+v", discordPoll, discordPoll.Answers, discordPoll.Results, discordPoll.Results.AnswerCounts)
-	}
-	var winningDates []time.Time
-	for _, answer := range discordPoll.Answers {
-		if contains(winningAnswerIDs, answer.AnswerID) {
-			dateStr := answer.Media.Text
-			var weekday string
-			var day, month, year int
-			_, err := fmt.Sscanf(dateStr, "%s %02d.%02d.%d", &weekday, &day, &month, &year)
+		metadata := parsePollMetadata(discordMessage.Content)
+		answerCounts := make(map[int]int)
+		if discordPoll.Results == nil {
+			finalized = false
+		} else {
+			finalized = finalized && discordPoll.Results.Finalized
+			for _, answerCount := range discordPoll.Results.AnswerCounts {
+				answerCounts[answerCount.ID] = answerCount.Count
+			}
+		}
+		for i, answer := range discordPoll.Answers {
+			count := answerCounts[answer.AnswerID]
+			date, err := toAnswerDate(&discordPoll.Answers[i], metadata, location)
 			if err != nil {
-				return nil, err
+				if count > 0 {
+					return nil, err
+				}
+				// answers without votes cannot win, so an unknown date does not matter
+				continue
+			}
+			answers = append(answers, date)
+			votes := poll.AnswerVotes{Date: date, Votes: count}
+			if voters != nil {
+				votes.Voters = voters[discordMessage.ID][answer.AnswerID]
+				if votes.Voters == nil {
+					votes.Voters = []string{}
+				}
 			}
-			winningDates = append(winningDates, time.Date(year, time.Month(month), day, 20, 0, 0, 0, location))
+			answerVotes = append(answerVotes, votes)
 		}
 	}
-	return poll.NewDatePollResult(discordMessage.ID, winningDates, discordPoll.Results.Finalized), nil
+	scores, err := strategy.Score(answerVotes)
+	if err != nil {
+		return nil, fmt.Errorf("could not score answers: %w", err)
+	}
+	// strategies may filter voters, so the total is taken from the scored votes
+	var totalVotes int
+	for i := range scores {
+		totalVotes += scores[i].Votes
+		scores[i].Voters = answerVotes[i].Voters
+	}
+	winningAnswers, winningScore := poll.SelectWinners(scores)
+	result := poll.NewDatePollResult(pollIDs, winningAnswers, winningScore, totalVotes, finalized)
+	result.Answers = answers
+	result.Scores = scores
+	result.Runoff = firstMetadata.Kind == pollKindRunoff
+	result.Expiry = expiry
+	// split polls carry their part number in the question
+	result.Question = strings.TrimSuffix(discordMessages[0].Poll.Question.Text, fmt.Sprintf(" (1/%d)", firstMetadata.Parts))
+	return result, nil
 }
 
-func contains(s []int, e int) bool {
-	for _, a := range s {
-		if a == e {
-			return true
-		}
+func toAnswerDate(answer *discordgo.PollAnswer, metadata pollMetadata, location *time.Location) (time.Time, error) {
+	if date, ok := metadata.answerDate(answer.AnswerID, location); ok {
+		return date, nil
+	}
+	// polls posted before answer dates were recorded in the metadata can only be parsed from the answer text
+	if answer.Media == nil {
+		return time.Time{}, fmt.Errorf("answer %d has neither a recorded date nor a text", answer.AnswerID)
+	}
+	return parseAnswerText(answer.Media.Text, location)
+}
+
+func parseAnswerText(text string, location *time.Location) (time.Time, error) {
+	var weekday string
+	var day, month, year int
+	startTime := poll.DefaultStartTime
+	n, err := fmt.Sscanf(text, "%s %02d.%02d.%d %02d:%02d", &weekday, &day, &month, &year, &startTime.Hour, &startTime.Minute)
+	if n == 4 {
+		// answers of polls posted before start times were configurable carry no time
+		startTime = poll.DefaultStartTime
+	} else if err != nil {
+		return time.Time{}, err
 	}
-	return false
+	return startTime.On(year, time.Month(month), day, location), nil
 }
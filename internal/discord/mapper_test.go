@@ -7,6 +7,7 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/klauspost/lctime"
+	"github.com/paschi/discord-date-decider/internal/event"
 	"github.com/paschi/discord-date-decider/internal/message"
 	"github.com/paschi/discord-date-decider/internal/poll"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +44,53 @@ func TestToDiscordMessage(t *testing.T) {
 	}
 }
 
+func TestToDiscordMessage_UserMentions(t *testing.T) {
+	discordMessage := toDiscordMessage(message.NewUserMentionMessage("<@alice> testMessage\n-# <@bob>", []string{"alice"}))
+
+	assert.Equal(t, "<@alice> testMessage\n-# <@bob>", discordMessage.Content)
+	if assert.NotNil(t, discordMessage.AllowedMentions) {
+		assert.Empty(t, discordMessage.AllowedMentions.Parse)
+		assert.Equal(t, []string{"alice"}, discordMessage.AllowedMentions.Users)
+	}
+}
+
+func TestToDiscordMessage_SilentUserMentions(t *testing.T) {
+	discordMessage := toDiscordMessage(message.NewUserMentionMessage("-# <@bob>", []string{}))
+
+	if assert.NotNil(t, discordMessage.AllowedMentions) {
+		assert.Empty(t, discordMessage.AllowedMentions.Parse)
+		assert.Empty(t, discordMessage.AllowedMentions.Users)
+	}
+}
+
+func TestToDiscordScheduledEvent(t *testing.T) {
+	start := time.Date(2025, time.November, 7, 20, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+
+	t.Run("voice channel event", func(t *testing.T) {
+		params := toDiscordScheduledEvent(event.NewEvent("Game Night", "Let's play!", start, 3*time.Hour, "voice-channel-id", ""))
+
+		assert.Equal(t, &discordgo.GuildScheduledEventParams{
+			Name:               "Game Night",
+			Description:        "Let's play!",
+			ScheduledStartTime: &start,
+			ScheduledEndTime:   &end,
+			PrivacyLevel:       discordgo.GuildScheduledEventPrivacyLevelGuildOnly,
+			EntityType:         discordgo.GuildScheduledEventEntityTypeVoice,
+			ChannelID:          "voice-channel-id",
+		}, params)
+	})
+
+	t.Run("external event", func(t *testing.T) {
+		params := toDiscordScheduledEvent(event.NewEvent("Game Night", "", start, 3*time.Hour, "", "Game Store"))
+
+		assert.Equal(t, discordgo.GuildScheduledEventEntityTypeExternal, params.EntityType)
+		assert.Empty(t, params.ChannelID)
+		assert.Equal(t, &discordgo.GuildScheduledEventEntityMetadata{Location: "Game Store"}, params.EntityMetadata)
+		assert.Equal(t, &end, params.ScheduledEndTime)
+	})
+}
+
 func TestToDiscordPollMessage(t *testing.T) {
 	futureDate := time.Now().AddDate(0, 1, 0)
 	pastDate := time.Now().AddDate(0, -1, 0)
@@ -55,12 +103,12 @@ func TestToDiscordPollMessage(t *testing.T) {
 	}{
 		{
 			name:        "valid poll",
-			poll:        poll.NewDatePoll("Test Poll Question", futureDate.Year(), futureDate.Month(), []time.Weekday{time.Friday, time.Saturday}, time.UTC, []int{}, []int{}),
+			poll:        poll.NewDatePoll("Test Poll Question", futureDate.Year(), futureDate.Month(), []time.Weekday{time.Friday, time.Saturday}, poll.NewStartTimes(poll.DefaultStartTime, nil), time.UTC, []int{}, []int{}, poll.DateFilter{}),
 			expectError: false,
 		},
 		{
 			name:        "expired poll",
-			poll:        poll.NewDatePoll("Expired Poll Question", pastDate.Year(), pastDate.Month(), []time.Weekday{time.Friday, time.Saturday}, time.UTC, []int{}, []int{}),
+			poll:        poll.NewDatePoll("Expired Poll Question", pastDate.Year(), pastDate.Month(), []time.Weekday{time.Friday, time.Saturday}, poll.NewStartTimes(poll.DefaultStartTime, nil), time.UTC, []int{}, []int{}, poll.DateFilter{}),
 			expectError: true,
 		},
 	}
@@ -79,8 +127,9 @@ func TestToDiscordPollMessage(t *testing.T) {
 				assert.Equal(t, parameter.poll.Question, discordPoll.Poll.Question.Text)
 				assert.Equal(t, len(parameter.poll.Answers), len(discordPoll.Poll.Answers))
 				assert.True(t, discordPoll.Poll.AllowMultiselect)
+				assert.Equal(t, len(parameter.poll.Answers), len(parsePollMetadata(discordPoll.Content).Answers))
 				for i, answer := range parameter.poll.Answers {
-					expectedText := fmt.Sprintf("%s, %02d.%02d.%d", answer.Weekday().String(), answer.Day(), answer.Month(), answer.Year())
+					expectedText := fmt.Sprintf("%s, %02d.%02d.%d %02d:%02d", answer.Weekday().String(), answer.Day(), answer.Month(), answer.Year(), answer.Hour(), answer.Minute())
 					assert.Equal(t, expectedText, discordPoll.Poll.Answers[i].Media.Text)
 				}
 			}
@@ -88,6 +137,63 @@ func TestToDiscordPollMessage(t *testing.T) {
 	}
 }
 
+func TestToDiscordPollMessage_PollPart(t *testing.T) {
+	datePoll := &poll.DatePoll{
+		Question: "Test Poll Question (2/2)",
+		Answers:  []time.Time{time.Now().AddDate(0, 1, 0).Truncate(time.Minute)},
+		Expiry:   time.Now().AddDate(0, 0, 7),
+		GroupID:  "GROUP",
+		Part:     2,
+		Parts:    2,
+	}
+
+	discordPoll, err := toDiscordPollMessage(datePoll)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, discordPoll) {
+		metadata := parsePollMetadata(discordPoll.Content)
+		assert.Equal(t, "GROUP", metadata.GroupID)
+		assert.Equal(t, 2, metadata.Part)
+		assert.Equal(t, 2, metadata.Parts)
+		if assert.Len(t, metadata.Answers, 1) {
+			assert.True(t, datePoll.Answers[0].Equal(metadata.Answers[0]))
+		}
+		assert.Equal(t, "Test Poll Question (2/2)", discordPoll.Poll.Question.Text)
+	}
+}
+
+func TestToDiscordPollMessage_RunoffPoll(t *testing.T) {
+	datePoll := poll.NewRunoffPoll("Runoff", []time.Time{time.Now().AddDate(0, 0, 3).Truncate(time.Minute)}, time.Now().Add(24*time.Hour+time.Minute), "")
+
+	discordPoll, err := toDiscordPollMessage(datePoll)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, discordPoll) {
+		assert.Equal(t, "runoff", parsePollMetadata(discordPoll.Content).Kind)
+		assert.Equal(t, 24, discordPoll.Poll.Duration)
+	}
+}
+
+func TestToDiscordPollMessage_FlaggedAnswers(t *testing.T) {
+	_ = lctime.SetLocale("en_US")
+	holiday := time.Date(time.Now().Year()+1, 10, 3, 20, 0, 0, 0, time.UTC)
+	regular := time.Date(time.Now().Year()+1, 10, 4, 20, 0, 0, 0, time.UTC)
+	datePoll := &poll.DatePoll{
+		Question: "Test Poll Question",
+		Answers:  []time.Time{holiday, regular},
+		Expiry:   time.Now().AddDate(0, 0, 7),
+		Flagged:  []time.Time{holiday},
+	}
+
+	discordPoll, err := toDiscordPollMessage(datePoll)
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, discordPoll) && assert.Len(t, discordPoll.Poll.Answers, 2) {
+		assert.Equal(t, fmt.Sprintf("%s, 03.10.%d 20:00 🎉", holiday.Weekday(), holiday.Year()), discordPoll.Poll.Answers[0].Media.Text)
+		assert.Equal(t, fmt.Sprintf("%s, 04.10.%d 20:00", regular.Weekday(), regular.Year()), discordPoll.Poll.Answers[1].Media.Text)
+	}
+}
+
 func TestToDatePollResult(t *testing.T) {
 	loc := time.UTC
 
@@ -113,10 +219,10 @@ func TestToDatePollResult(t *testing.T) {
 			},
 		}
 
-		result, err := toDatePollResult(msg, loc)
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.PluralityStrategy{}, nil)
 		assert.NoError(t, err)
 		if assert.NotNil(t, result) {
-			assert.Equal(t, "123", result.PollID)
+			assert.Equal(t, []string{"123"}, result.PollIDs)
 			assert.True(t, result.Finalized)
 			if assert.Len(t, result.WinningAnswers, 1) {
 				expected := time.Date(2025, 8, 15, 20, 0, 0, 0, loc)
@@ -125,6 +231,21 @@ func TestToDatePollResult(t *testing.T) {
 		}
 	})
 
+	t.Run("latest expiry of poll parts", func(t *testing.T) {
+		date := time.Date(2025, 8, 15, 0, 0, 0, 0, loc)
+		earlier := time.Date(2025, 8, 1, 12, 0, 0, 0, loc)
+		later := time.Date(2025, 8, 1, 13, 0, 0, 0, loc)
+		part1 := &discordgo.Message{ID: "1", Poll: &discordgo.Poll{Answers: []discordgo.PollAnswer{makeAnswer(1, date)}, Expiry: &later}}
+		part2 := &discordgo.Message{ID: "2", Poll: &discordgo.Poll{Answers: []discordgo.PollAnswer{makeAnswer(1, date)}, Expiry: &earlier}}
+
+		result, err := toDatePollResult([]*discordgo.Message{part1, part2}, loc, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, later, result.Expiry)
+			assert.False(t, result.Finalized)
+		}
+	})
+
 	t.Run("tie between two answers", func(t *testing.T) {
 		date1 := time.Date(2025, 12, 5, 0, 0, 0, 0, loc)  // Friday
 		date2 := time.Date(2025, 12, 12, 0, 0, 0, 0, loc) // Friday
@@ -142,10 +263,10 @@ func TestToDatePollResult(t *testing.T) {
 			},
 		}
 
-		result, err := toDatePollResult(msg, loc)
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.PluralityStrategy{}, nil)
 		assert.NoError(t, err)
 		if assert.NotNil(t, result) {
-			assert.Equal(t, "456", result.PollID)
+			assert.Equal(t, []string{"456"}, result.PollIDs)
 			assert.False(t, result.Finalized)
 			if assert.Len(t, result.WinningAnswers, 2) {
 				expected1 := time.Date(2025, 12, 5, 20, 0, 0, 0, loc)
@@ -157,14 +278,260 @@ func TestToDatePollResult(t *testing.T) {
 		}
 	})
 
+	t.Run("winner across poll parts", func(t *testing.T) {
+		part1 := &discordgo.Message{
+			ID: "part-1",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{
+					makeAnswer(1, time.Date(2025, 8, 1, 0, 0, 0, 0, loc)),
+					makeAnswer(2, time.Date(2025, 8, 2, 0, 0, 0, 0, loc)),
+				},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 3}, {ID: 2, Count: 5}},
+				},
+			},
+		}
+		part2 := &discordgo.Message{
+			ID: "part-2",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{
+					makeAnswer(1, time.Date(2025, 8, 29, 0, 0, 0, 0, loc)),
+					makeAnswer(2, time.Date(2025, 8, 30, 0, 0, 0, 0, loc)),
+				},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 5}, {ID: 2, Count: 4}},
+				},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{part1, part2}, loc, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []string{"part-1", "part-2"}, result.PollIDs)
+			assert.True(t, result.Finalized)
+			assert.Equal(t, 5.0, result.WinningScore)
+			assert.Equal(t, 17, result.TotalVotes)
+			assert.Len(t, result.Answers, 4)
+			assert.Equal(t, []time.Time{
+				time.Date(2025, 8, 2, 20, 0, 0, 0, loc),
+				time.Date(2025, 8, 29, 20, 0, 0, 0, loc),
+			}, result.WinningAnswers)
+		}
+	})
+
+	t.Run("not finalized if any poll part is still open", func(t *testing.T) {
+		part1 := &discordgo.Message{
+			ID: "part-1",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{makeAnswer(1, time.Date(2025, 8, 1, 0, 0, 0, 0, loc))},
+				Results: &discordgo.PollResults{Finalized: true, AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 3}}},
+			},
+		}
+		part2 := &discordgo.Message{
+			ID: "part-2",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{makeAnswer(1, time.Date(2025, 8, 29, 0, 0, 0, 0, loc))},
+				Results: &discordgo.PollResults{Finalized: false, AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 1}}},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{part1, part2}, loc, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.False(t, result.Finalized)
+		}
+	})
+
+	t.Run("question and kind of runoff poll parts", func(t *testing.T) {
+		part1 := &discordgo.Message{
+			ID:      "part-1",
+			Content: pollMetadata{Kind: "runoff", GroupID: "GROUP", Part: 1, Parts: 2}.String(),
+			Poll: &discordgo.Poll{
+				Question: discordgo.PollMedia{Text: "Runoff: Poll for August 2025 (1/2)"},
+				Answers:  []discordgo.PollAnswer{makeAnswer(1, time.Date(2025, 8, 1, 0, 0, 0, 0, loc))},
+				Results:  &discordgo.PollResults{Finalized: true, AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 3}}},
+			},
+		}
+		part2 := &discordgo.Message{
+			ID:      "part-2",
+			Content: pollMetadata{Kind: "runoff", GroupID: "GROUP", Part: 2, Parts: 2}.String(),
+			Poll: &discordgo.Poll{
+				Question: discordgo.PollMedia{Text: "Runoff: Poll for August 2025 (2/2)"},
+				Answers:  []discordgo.PollAnswer{makeAnswer(1, time.Date(2025, 8, 29, 0, 0, 0, 0, loc))},
+				Results:  &discordgo.PollResults{Finalized: true, AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 1}}},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{part1, part2}, loc, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.True(t, result.Runoff)
+			assert.Equal(t, "Runoff: Poll for August 2025", result.Question)
+		}
+	})
+
+	t.Run("winner from recorded answer dates", func(t *testing.T) {
+		berlin, _ := time.LoadLocation("Europe/Berlin")
+		date1 := time.Date(2026, 10, 2, 20, 0, 0, 0, berlin)
+		date2 := time.Date(2026, 10, 3, 14, 30, 0, 0, berlin)
+		msg := &discordgo.Message{
+			ID:      "654",
+			Content: pollMetadata{Answers: []time.Time{date1, date2}}.String(),
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{
+					{AnswerID: 1, Media: &discordgo.PollMedia{Text: "vendredi 2 octobre"}},
+					{AnswerID: 2, Media: &discordgo.PollMedia{Text: "samedi 3 octobre"}},
+				},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 2}, {ID: 2, Count: 4}},
+				},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, berlin, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []time.Time{date2}, result.WinningAnswers)
+		}
+	})
+
+	t.Run("winner with start time in answer", func(t *testing.T) {
+		msg := &discordgo.Message{
+			ID: "321",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{
+					{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Friday, 02.10.2026 20:00"}},
+					{AnswerID: 2, Media: &discordgo.PollMedia{Text: "Saturday, 03.10.2026 14:30 🎉"}},
+				},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 2}, {ID: 2, Count: 4}},
+				},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) && assert.Len(t, result.WinningAnswers, 1) {
+			assert.Equal(t, time.Date(2026, 10, 3, 14, 30, 0, 0, loc), result.WinningAnswers[0])
+		}
+	})
+
+	t.Run("winner by approval strategy with scores", func(t *testing.T) {
+		date1 := time.Date(2025, 8, 15, 0, 0, 0, 0, loc)
+		date2 := time.Date(2025, 8, 16, 0, 0, 0, 0, loc)
+		msg := &discordgo.Message{
+			ID: "123",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{makeAnswer(1, date1), makeAnswer(2, date2)},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 5}, {ID: 2, Count: 3}},
+				},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.ApprovalStrategy{Threshold: 6}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Empty(t, result.WinningAnswers)
+			assert.Equal(t, 8, result.TotalVotes)
+			assert.Equal(t, []poll.AnswerScore{
+				{Date: time.Date(2025, 8, 15, 20, 0, 0, 0, loc), Votes: 5, Score: 5, Eligible: false},
+				{Date: time.Date(2025, 8, 16, 20, 0, 0, 0, loc), Votes: 3, Score: 3, Eligible: false},
+			}, result.Scores)
+		}
+	})
+
+	t.Run("required members exclude dates they did not vote for", func(t *testing.T) {
+		date1 := time.Date(2025, 8, 15, 0, 0, 0, 0, loc)
+		date2 := time.Date(2025, 8, 16, 0, 0, 0, 0, loc)
+		msg := &discordgo.Message{
+			ID: "123",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{makeAnswer(1, date1), makeAnswer(2, date2)},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 3}, {ID: 2, Count: 2}},
+				},
+			},
+		}
+		voters := pollVoters{"123": {1: {"bob", "carol", "dave"}, 2: {"alice", "bob"}}}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.RequiredMembersStrategy{Members: []string{"alice"}}, voters)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []time.Time{time.Date(2025, 8, 16, 20, 0, 0, 0, loc)}, result.WinningAnswers)
+			assert.Equal(t, 2.0, result.WinningScore)
+		}
+	})
+
+	t.Run("eligible voters reduce total votes", func(t *testing.T) {
+		date1 := time.Date(2025, 8, 15, 0, 0, 0, 0, loc)
+		date2 := time.Date(2025, 8, 16, 0, 0, 0, 0, loc)
+		msg := &discordgo.Message{
+			ID: "123",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{makeAnswer(1, date1), makeAnswer(2, date2)},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 3}, {ID: 2, Count: 2}},
+				},
+			},
+		}
+		voters := pollVoters{"123": {1: {"guest-1", "guest-2", "alice"}, 2: {"alice", "bob"}}}
+		strategy := poll.EligibleVotersStrategy{Roles: []string{"member"}, MemberRoles: map[string][]string{"alice": {"member"}, "bob": {"member"}}}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, strategy, voters)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []time.Time{time.Date(2025, 8, 16, 20, 0, 0, 0, loc)}, result.WinningAnswers)
+			assert.Equal(t, 3, result.TotalVotes)
+		}
+	})
+
+	t.Run("strategy without voters returns error", func(t *testing.T) {
+		msg := &discordgo.Message{
+			ID: "123",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{makeAnswer(1, time.Date(2025, 8, 15, 0, 0, 0, 0, loc))},
+				Results: &discordgo.PollResults{Finalized: true, AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 5}}},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.RequiredMembersStrategy{Members: []string{"alice"}}, nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("unparsable answer returns error", func(t *testing.T) {
+		msg := &discordgo.Message{
+			ID: "654",
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Friday, 02.10.2026 20h"}}},
+				Results: &discordgo.PollResults{
+					Finalized:    true,
+					AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 2}},
+				},
+			},
+		}
+
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.PluralityStrategy{}, nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
 	t.Run("no poll in message returns error", func(t *testing.T) {
 		msg := &discordgo.Message{ID: "789"}
-		result, err := toDatePollResult(msg, loc)
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.PluralityStrategy{}, nil)
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
 
-	t.Run("no answer counts returns error", func(t *testing.T) {
+	t.Run("no answer counts returns no winner", func(t *testing.T) {
 		date1 := time.Date(2025, 1, 3, 0, 0, 0, 0, loc)
 		msg := &discordgo.Message{
 			ID: "999",
@@ -173,8 +540,13 @@ func TestToDatePollResult(t *testing.T) {
 				Results: &discordgo.PollResults{Finalized: true, AnswerCounts: []*discordgo.PollAnswerCount{}},
 			},
 		}
-		result, err := toDatePollResult(msg, loc)
-		assert.Error(t, err)
-		assert.Nil(t, result)
+		result, err := toDatePollResult([]*discordgo.Message{msg}, loc, poll.PluralityStrategy{}, nil)
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Empty(t, result.WinningAnswers)
+			assert.Zero(t, result.WinningScore)
+			assert.Zero(t, result.TotalVotes)
+			assert.Equal(t, []time.Time{time.Date(2025, 1, 3, 20, 0, 0, 0, loc)}, result.Answers)
+		}
 	})
 }
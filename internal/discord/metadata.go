@@ -0,0 +1,99 @@
+package discord
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	metadataPrefix = "-# date-decider?"
+	pollKindRunoff = "runoff"
+)
+
+type pollMetadata struct {
+	Kind    string
+	GroupID string
+	Part    int
+	Parts   int
+	// period and profile identify the poll of a startPoll request, so that it is not posted twice
+	Period  string
+	Profile string
+	// answers in the order they were sent, discord assigns answer ids sequentially starting at 1
+	Answers []time.Time
+}
+
+func (m pollMetadata) String() string {
+	values := url.Values{}
+	if m.Kind != "" {
+		values.Set("kind", m.Kind)
+	}
+	if m.GroupID != "" {
+		values.Set("group", m.GroupID)
+		values.Set("part", strconv.Itoa(m.Part))
+		values.Set("parts", strconv.Itoa(m.Parts))
+	}
+	if m.Period != "" {
+		values.Set("period", m.Period)
+	}
+	if m.Profile != "" {
+		values.Set("profile", m.Profile)
+	}
+	if len(m.Answers) > 0 {
+		var answers []string
+		for _, answer := range m.Answers {
+			answers = append(answers, strconv.FormatInt(answer.Unix(), 10))
+		}
+		values.Set("answers", strings.Join(answers, ","))
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return metadataPrefix + values.Encode()
+}
+
+func (m pollMetadata) answerDate(answerID int, location *time.Location) (time.Time, bool) {
+	if answerID < 1 || answerID > len(m.Answers) {
+		return time.Time{}, false
+	}
+	return m.Answers[answerID-1].In(location), true
+}
+
+func parsePollMetadata(content string) pollMetadata {
+	var metadata pollMetadata
+	for _, line := range strings.Split(content, "\n") {
+		encoded, found := strings.CutPrefix(strings.TrimSpace(line), metadataPrefix)
+		if !found {
+			continue
+		}
+		values, err := url.ParseQuery(encoded)
+		if err != nil {
+			continue
+		}
+		metadata.Kind = values.Get("kind")
+		metadata.GroupID = values.Get("group")
+		metadata.Part, _ = strconv.Atoi(values.Get("part"))
+		metadata.Parts, _ = strconv.Atoi(values.Get("parts"))
+		metadata.Period = values.Get("period")
+		metadata.Profile = values.Get("profile")
+		metadata.Answers = parseAnswerDates(values.Get("answers"))
+	}
+	return metadata
+}
+
+func parseAnswerDates(value string) []time.Time {
+	if value == "" {
+		return nil
+	}
+	var answers []time.Time
+	for _, part := range strings.Split(value, ",") {
+		seconds, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			// a partial mapping would assign dates to the wrong answer ids
+			return nil
+		}
+		answers = append(answers, time.Unix(seconds, 0).UTC())
+	}
+	return answers
+}
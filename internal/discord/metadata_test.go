@@ -0,0 +1,84 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPollMetadata(t *testing.T) {
+	parameters := []struct {
+		name            string
+		metadata        pollMetadata
+		expectedContent string
+	}{
+		{
+			name:            "no metadata",
+			metadata:        pollMetadata{},
+			expectedContent: "",
+		},
+		{
+			name:            "poll group part",
+			metadata:        pollMetadata{GroupID: "ABC123", Part: 2, Parts: 3},
+			expectedContent: "-# date-decider?group=ABC123&part=2&parts=3",
+		},
+		{
+			name:            "runoff poll",
+			metadata:        pollMetadata{Kind: "runoff"},
+			expectedContent: "-# date-decider?kind=runoff",
+		},
+		{
+			name:            "period and profile",
+			metadata:        pollMetadata{Period: "2026-11", Profile: "game night"},
+			expectedContent: "-# date-decider?period=2026-11&profile=game+night",
+		},
+		{
+			name: "answer dates",
+			metadata: pollMetadata{Answers: []time.Time{
+				time.Date(2026, 10, 2, 18, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 3, 12, 30, 0, 0, time.UTC),
+			}},
+			expectedContent: "-# date-decider?answers=1790964000%2C1791030600",
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			content := parameter.metadata.String()
+
+			assert.Equal(t, parameter.expectedContent, content)
+			assert.Equal(t, parameter.metadata, parsePollMetadata(content))
+		})
+	}
+}
+
+func TestParsePollMetadata(t *testing.T) {
+	t.Run("metadata after other content", func(t *testing.T) {
+		metadata := parsePollMetadata("Vote now!\n-# date-decider?group=XYZ&part=1&parts=2")
+
+		assert.Equal(t, pollMetadata{GroupID: "XYZ", Part: 1, Parts: 2}, metadata)
+	})
+
+	t.Run("content without metadata", func(t *testing.T) {
+		metadata := parsePollMetadata("-# just some small text")
+
+		assert.Equal(t, pollMetadata{}, metadata)
+	})
+}
+
+func TestPollMetadata_AnswerDate(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	metadata := parsePollMetadata("-# date-decider?answers=1790964000%2C1791030600")
+
+	date, ok := metadata.answerDate(2, berlin)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 3, 14, 30, 0, 0, berlin), date)
+
+	_, ok = metadata.answerDate(0, berlin)
+	assert.False(t, ok)
+	_, ok = metadata.answerDate(3, berlin)
+	assert.False(t, ok)
+	_, ok = parsePollMetadata("-# date-decider?answers=1790964000%2Cinvalid").answerDate(1, berlin)
+	assert.False(t, ok)
+}
@@ -0,0 +1,196 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+type RetryPolicy struct {
+	Attempts  int
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{Attempts: 4, BaseDelay: 500 * time.Millisecond, MaxDelay: 10 * time.Second}
+
+// RetryClient retries rate limits and server errors of the wrapped client until the context deadline,
+// requests that create something are only retried on rate limits, because a server error might have created it anyway
+type RetryClient struct {
+	ctx    context.Context
+	client Client
+	policy RetryPolicy
+}
+
+func NewRetryClient(ctx context.Context, client Client, policy RetryPolicy) *RetryClient {
+	return &RetryClient{ctx: ctx, client: client, policy: policy}
+}
+
+func (c *RetryClient) Open() error {
+	return c.client.Open()
+}
+
+func (c *RetryClient) Close() error {
+	return c.client.Close()
+}
+
+func (c *RetryClient) ChannelMessageSend(channelID string, data *discordgo.MessageSend) (*discordgo.Message, error) {
+	return retryRateLimited(c, func() (*discordgo.Message, error) {
+		return c.client.ChannelMessageSend(channelID, data)
+	})
+}
+
+func (c *RetryClient) ChannelMessagePin(channelID string, messageID string) error {
+	return retryError(c, func() error {
+		return c.client.ChannelMessagePin(channelID, messageID)
+	})
+}
+
+func (c *RetryClient) ChannelMessageUnpin(channelID string, messageID string) error {
+	return retryError(c, func() error {
+		return c.client.ChannelMessageUnpin(channelID, messageID)
+	})
+}
+
+func (c *RetryClient) ChannelMessageDelete(channelID string, messageID string) error {
+	return retryError(c, func() error {
+		return c.client.ChannelMessageDelete(channelID, messageID)
+	})
+}
+
+func (c *RetryClient) ChannelMessagesPinned(channelID string) ([]*discordgo.Message, error) {
+	return retry(c, func() ([]*discordgo.Message, error) {
+		return c.client.ChannelMessagesPinned(channelID)
+	})
+}
+
+func (c *RetryClient) ChannelMessages(channelID string, limit int) ([]*discordgo.Message, error) {
+	return retry(c, func() ([]*discordgo.Message, error) {
+		return c.client.ChannelMessages(channelID, limit)
+	})
+}
+
+func (c *RetryClient) MessageReactionAdd(channelID string, messageID string, emoji string) error {
+	return retryError(c, func() error {
+		return c.client.MessageReactionAdd(channelID, messageID, emoji)
+	})
+}
+
+func (c *RetryClient) PollAnswerVoters(channelID string, messageID string, answerID int, after string, limit int) ([]*discordgo.User, error) {
+	return retry(c, func() ([]*discordgo.User, error) {
+		return c.client.PollAnswerVoters(channelID, messageID, answerID, after, limit)
+	})
+}
+
+func (c *RetryClient) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
+	return retry(c, func() ([]*discordgo.Member, error) {
+		return c.client.GuildMembers(guildID, after, limit)
+	})
+}
+
+func (c *RetryClient) ExpirePoll(channelID string, messageID string) error {
+	return retryError(c, func() error {
+		return c.client.ExpirePoll(channelID, messageID)
+	})
+}
+
+func (c *RetryClient) GuildScheduledEventCreate(guildID string, params *discordgo.GuildScheduledEventParams) (*discordgo.GuildScheduledEvent, error) {
+	return retryRateLimited(c, func() (*discordgo.GuildScheduledEvent, error) {
+		return c.client.GuildScheduledEventCreate(guildID, params)
+	})
+}
+
+func (c *RetryClient) GuildScheduledEventDelete(guildID string, eventID string) error {
+	return retryError(c, func() error {
+		return c.client.GuildScheduledEventDelete(guildID, eventID)
+	})
+}
+
+func retry[T any](c *RetryClient, call func() (T, error)) (T, error) {
+	return retryWhen(c, isTransient, call)
+}
+
+// retryRateLimited is used for requests that are not idempotent, a rate limited request was rejected before it was processed
+func retryRateLimited[T any](c *RetryClient, call func() (T, error)) (T, error) {
+	return retryWhen(c, isRateLimited, call)
+}
+
+func retryWhen[T any](c *RetryClient, retryable func(error) bool, call func() (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		result, err := call()
+		if err == nil || attempt >= c.policy.Attempts || !retryable(err) {
+			return result, err
+		}
+		delay := c.policy.delay(attempt, retryAfter(err))
+		// giving up early leaves time to handle the error before the deadline
+		if deadline, ok := c.ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return result, err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return result, errors.Join(err, c.ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func retryError(c *RetryClient, call func() error) error {
+	_, err := retry(c, func() (struct{}, error) {
+		return struct{}{}, call()
+	})
+	return err
+}
+
+// delay grows exponentially with jitter, but never undercuts the delay requested by discord
+func (p RetryPolicy) delay(attempt int, retryAfter time.Duration) time.Duration {
+	delay := min(p.BaseDelay<<(attempt-1), p.MaxDelay)
+	if delay > 0 {
+		delay = delay/2 + rand.N(delay/2+1)
+	}
+	return max(delay, retryAfter)
+}
+
+// isTransient reports rate limits, server errors and network errors, other errors like missing permissions fail immediately
+func isTransient(err error) bool {
+	if isRateLimited(err) {
+		return true
+	}
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) {
+		return restErr.Response != nil && restErr.Response.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func isRateLimited(err error) bool {
+	var rateLimitErr *discordgo.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+	var restErr *discordgo.RESTError
+	return errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusTooManyRequests
+}
+
+func retryAfter(err error) time.Duration {
+	var rateLimitErr *discordgo.RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RateLimit != nil && rateLimitErr.TooManyRequests != nil {
+		return rateLimitErr.RetryAfter
+	}
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Response != nil {
+		seconds, parseErr := strconv.ParseFloat(restErr.Response.Header.Get("Retry-After"), 64)
+		if parseErr == nil && seconds > 0 {
+			return time.Duration(seconds * float64(time.Second))
+		}
+	}
+	return 0
+}
@@ -0,0 +1,251 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+var testRetryPolicy = RetryPolicy{Attempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+func newRestError(statusCode int, retryAfter string) *discordgo.RESTError {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &discordgo.RESTError{Response: &http.Response{StatusCode: statusCode, Header: header}}
+}
+
+func newRateLimitError(retryAfter time.Duration) *discordgo.RateLimitError {
+	return &discordgo.RateLimitError{RateLimit: &discordgo.RateLimit{TooManyRequests: &discordgo.TooManyRequests{RetryAfter: retryAfter}}}
+}
+
+func TestRetryClient_ChannelMessageSend(t *testing.T) {
+	data := &discordgo.MessageSend{Content: "test"}
+
+	t.Run("successful retry after rate limits", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, newRateLimitError(time.Millisecond)).Once()
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, newRestError(http.StatusTooManyRequests, "")).Once()
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(&discordgo.Message{ID: "message-id"}, nil).Once()
+
+		client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+		message, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "message-id", message.ID)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error after all attempts", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := newRestError(http.StatusTooManyRequests, "")
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, expectedErr).Times(3)
+
+		client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+		message, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, message)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error without retry for missing permissions", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := newRestError(http.StatusForbidden, "")
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, expectedErr).Once()
+
+		client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+		_, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.Equal(t, expectedErr, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error without retry for server errors", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := newRestError(http.StatusBadGateway, "")
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, expectedErr).Once()
+
+		client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+		_, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.Equal(t, expectedErr, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error without retry for network errors", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := &net.OpError{Op: "read", Err: errors.New("connection reset")}
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, expectedErr).Once()
+
+		client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+		_, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.Equal(t, expectedErr, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error without retry beyond deadline", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := newRateLimitError(time.Minute)
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, expectedErr).Once()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		client := NewRetryClient(ctx, mockClient, testRetryPolicy)
+		_, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.Equal(t, expectedErr, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error when context is canceled while waiting", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := newRateLimitError(time.Minute)
+		mockClient.On("ChannelMessageSend", "channel-id", data).Return(nil, expectedErr).Once()
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		client := NewRetryClient(ctx, mockClient, testRetryPolicy)
+		_, err := client.ChannelMessageSend("channel-id", data)
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.ErrorIs(t, err, context.Canceled)
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestRetryClient_ChannelMessagePin(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ChannelMessagePin", "channel-id", "message-id").Return(newRestError(http.StatusTooManyRequests, "0.001")).Once()
+	mockClient.On("ChannelMessagePin", "channel-id", "message-id").Return(nil).Once()
+
+	client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+	err := client.ChannelMessagePin("channel-id", "message-id")
+
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRetryClient_ChannelMessagesPinned(t *testing.T) {
+	mockClient := new(MockClient)
+	mockClient.On("ChannelMessagesPinned", "channel-id").Return(nil, newRestError(http.StatusBadGateway, "")).Once()
+	mockClient.On("ChannelMessagesPinned", "channel-id").Return(nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}).Once()
+	mockClient.On("ChannelMessagesPinned", "channel-id").Return([]*discordgo.Message{{ID: "message-id"}}, nil).Once()
+
+	client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+	messages, err := client.ChannelMessagesPinned("channel-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*discordgo.Message{{ID: "message-id"}}, messages)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRetryClient_GuildScheduledEventCreate(t *testing.T) {
+	params := &discordgo.GuildScheduledEventParams{Name: "Game Night"}
+	mockClient := new(MockClient)
+	mockClient.On("GuildScheduledEventCreate", "guild-id", params).Return(nil, newRateLimitError(time.Millisecond)).Once()
+	mockClient.On("GuildScheduledEventCreate", "guild-id", params).Return(nil, newRestError(http.StatusInternalServerError, "")).Once()
+
+	client := NewRetryClient(context.Background(), mockClient, testRetryPolicy)
+	_, err := client.GuildScheduledEventCreate("guild-id", params)
+
+	assert.Equal(t, newRestError(http.StatusInternalServerError, ""), err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestIsRateLimited(t *testing.T) {
+	parameters := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "rate limit", err: newRateLimitError(time.Second), expected: true},
+		{name: "too many requests", err: newRestError(http.StatusTooManyRequests, ""), expected: true},
+		{name: "server error", err: newRestError(http.StatusInternalServerError, ""), expected: false},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, expected: false},
+		{name: "rest error without response", err: &discordgo.RESTError{}, expected: false},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			assert.Equal(t, parameter.expected, isRateLimited(parameter.err))
+		})
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	parameters := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "rate limit", err: newRateLimitError(time.Second), expected: true},
+		{name: "too many requests", err: newRestError(http.StatusTooManyRequests, ""), expected: true},
+		{name: "server error", err: newRestError(http.StatusInternalServerError, ""), expected: true},
+		{name: "wrapped server error", err: errors.Join(errors.New("request failed"), newRestError(http.StatusBadGateway, "")), expected: true},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, expected: true},
+		{name: "validation error", err: newRestError(http.StatusBadRequest, ""), expected: false},
+		{name: "missing permissions", err: newRestError(http.StatusForbidden, ""), expected: false},
+		{name: "rest error without response", err: &discordgo.RESTError{}, expected: false},
+		{name: "other error", err: errors.New("unexpected"), expected: false},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			assert.Equal(t, parameter.expected, isTransient(parameter.err))
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	parameters := []struct {
+		name     string
+		err      error
+		expected time.Duration
+	}{
+		{name: "rate limit", err: newRateLimitError(1500 * time.Millisecond), expected: 1500 * time.Millisecond},
+		{name: "retry after header", err: newRestError(http.StatusTooManyRequests, "2.5"), expected: 2500 * time.Millisecond},
+		{name: "invalid retry after header", err: newRestError(http.StatusTooManyRequests, "soon"), expected: 0},
+		{name: "no retry after", err: newRestError(http.StatusInternalServerError, ""), expected: 0},
+		{name: "other error", err: errors.New("unexpected"), expected: 0},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			assert.Equal(t, parameter.expected, retryAfter(parameter.err))
+		})
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{Attempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	parameters := []struct {
+		name       string
+		attempt    int
+		retryAfter time.Duration
+		minimum    time.Duration
+		maximum    time.Duration
+	}{
+		{name: "first attempt", attempt: 1, minimum: 50 * time.Millisecond, maximum: 100 * time.Millisecond},
+		{name: "second attempt", attempt: 2, minimum: 100 * time.Millisecond, maximum: 200 * time.Millisecond},
+		{name: "capped attempt", attempt: 4, minimum: 150 * time.Millisecond, maximum: 300 * time.Millisecond},
+		{name: "longer retry after", attempt: 1, retryAfter: time.Second, minimum: time.Second, maximum: time.Second},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			delay := policy.delay(parameter.attempt, parameter.retryAfter)
+
+			assert.GreaterOrEqual(t, delay, parameter.minimum)
+			assert.LessOrEqual(t, delay, parameter.maximum)
+		})
+	}
+}
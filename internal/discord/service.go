@@ -2,8 +2,11 @@ package discord
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/bwmarrin/discordgo"
+	"github.com/paschi/discord-date-decider/internal/event"
 	"github.com/paschi/discord-date-decider/internal/message"
 	"github.com/paschi/discord-date-decider/internal/poll"
 )
@@ -15,9 +18,27 @@ type Service interface {
 	SendPoll(channelID string, poll *poll.DatePoll) (string, error)
 	PinPoll(channelID string, pollID string) error
 	UnpinPoll(channelID string, pollID string) error
-	GetLastPinnedPollResult(channelID string, location *time.Location) (*poll.DatePollResult, error)
+	DeleteMessage(channelID string, messageID string) error
+	FindPolls(channelID string, period string, profile string) ([]poll.PostedPoll, error)
+	MarkPollAnnounced(channelID string, pollID string) error
+	ExpirePoll(channelID string, pollID string) error
+	GetLastPinnedPollResult(channelID string, location *time.Location, strategy poll.ResultStrategy, withVoters bool) (*poll.DatePollResult, error)
+	GetRoleMembers(guildID string, roleID string) ([]string, error)
+	GetMemberRoles(guildID string) (map[string][]string, error)
+	CreateEvent(guildID string, event *event.Event) (string, error)
+	DeleteEvent(guildID string, eventID string) error
 }
 
+const (
+	maxVotersPerPage  = 100
+	maxMembersPerPage = 1000
+	maxRecentMessages = 50
+	announcedReaction = "📣"
+)
+
+// pollVoters holds the user ids of the voters by message id and answer id
+type pollVoters map[string]map[int][]string
+
 type DefaultService struct {
 	client Client
 }
@@ -50,6 +71,22 @@ func (d *DefaultService) SendMessage(channelID string, message *message.Message)
 	return discordMessage.ID, nil
 }
 
+func (d *DefaultService) CreateEvent(guildID string, event *event.Event) (string, error) {
+	scheduledEvent, err := d.client.GuildScheduledEventCreate(guildID, toDiscordScheduledEvent(event))
+	if err != nil {
+		return "", fmt.Errorf("could not create scheduled event in guild: %w", err)
+	}
+	return scheduledEvent.ID, nil
+}
+
+func (d *DefaultService) DeleteEvent(guildID string, eventID string) error {
+	err := d.client.GuildScheduledEventDelete(guildID, eventID)
+	if err != nil {
+		return fmt.Errorf("could not delete scheduled event from guild: %w", err)
+	}
+	return nil
+}
+
 func (d *DefaultService) SendPoll(channelID string, poll *poll.DatePoll) (string, error) {
 	discordPoll, err := toDiscordPollMessage(poll)
 	if err != nil {
@@ -78,7 +115,65 @@ func (d *DefaultService) UnpinPoll(channelID string, pollID string) error {
 	return nil
 }
 
-func (d *DefaultService) GetLastPinnedPollResult(channelID string, location *time.Location) (*poll.DatePollResult, error) {
+func (d *DefaultService) DeleteMessage(channelID string, messageID string) error {
+	err := d.client.ChannelMessageDelete(channelID, messageID)
+	if err != nil {
+		return fmt.Errorf("could not delete message from channel: %w", err)
+	}
+	return nil
+}
+
+// FindPolls returns the parts of the latest open poll for the period and profile among pinned and recent messages
+func (d *DefaultService) FindPolls(channelID string, period string, profile string) ([]poll.PostedPoll, error) {
+	pinnedMessages, err := d.client.ChannelMessagesPinned(channelID)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pinned messages: %w", err)
+	}
+	recentMessages, err := d.client.ChannelMessages(channelID, maxRecentMessages)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve recent messages: %w", err)
+	}
+	var postedPolls []poll.PostedPoll
+	for _, discordMessage := range slices.Concat(pinnedMessages, recentMessages) {
+		if discordMessage.Poll == nil || isPollEnded(discordMessage.Poll) || slices.ContainsFunc(postedPolls, func(postedPoll poll.PostedPoll) bool {
+			return postedPoll.ID == discordMessage.ID
+		}) {
+			continue
+		}
+		metadata := parsePollMetadata(discordMessage.Content)
+		if metadata.Kind != "" || metadata.Period != period || metadata.Profile != profile {
+			continue
+		}
+		if len(postedPolls) > 0 && postedPolls[0].GroupID != metadata.GroupID {
+			continue
+		}
+		// recent messages might not report the pin, so the pinned messages are checked as well
+		pinned := discordMessage.Pinned || slices.ContainsFunc(pinnedMessages, func(pinnedMessage *discordgo.Message) bool {
+			return pinnedMessage.ID == discordMessage.ID
+		})
+		postedPolls = append(postedPolls, toPostedPoll(discordMessage, metadata, pinned))
+	}
+	return postedPolls, nil
+}
+
+// MarkPollAnnounced reacts to the poll, so that a repeated request does not announce it again
+func (d *DefaultService) MarkPollAnnounced(channelID string, pollID string) error {
+	err := d.client.MessageReactionAdd(channelID, pollID, announcedReaction)
+	if err != nil {
+		return fmt.Errorf("could not mark poll as announced: %w", err)
+	}
+	return nil
+}
+
+func (d *DefaultService) ExpirePoll(channelID string, pollID string) error {
+	err := d.client.ExpirePoll(channelID, pollID)
+	if err != nil {
+		return fmt.Errorf("could not expire poll: %w", err)
+	}
+	return nil
+}
+
+func (d *DefaultService) GetLastPinnedPollResult(channelID string, location *time.Location, strategy poll.ResultStrategy, withVoters bool) (*poll.DatePollResult, error) {
 	pinnedMessages, err := d.client.ChannelMessagesPinned(channelID)
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve pinned messages: %w", err)
@@ -87,7 +182,18 @@ func (d *DefaultService) GetLastPinnedPollResult(channelID string, location *tim
 		if pinnedMessage.Poll == nil {
 			continue
 		}
-		result, err := toDatePollResult(pinnedMessage, location)
+		pollMessages, err := getPollGroup(pinnedMessages, pinnedMessage)
+		if err != nil {
+			return nil, fmt.Errorf("could not collect poll group: %w", err)
+		}
+		var voters pollVoters
+		if withVoters || strategy.RequiresVoters() {
+			voters, err = d.getPollVoters(pollMessages)
+			if err != nil {
+				return nil, fmt.Errorf("could not retrieve poll voters: %w", err)
+			}
+		}
+		result, err := toDatePollResult(pollMessages, location, strategy, voters)
 		if err != nil {
 			return nil, fmt.Errorf("could not convert message to date poll result: %w", err)
 		}
@@ -95,3 +201,112 @@ func (d *DefaultService) GetLastPinnedPollResult(channelID string, location *tim
 	}
 	return nil, fmt.Errorf("could not find last pinned poll")
 }
+
+func getPollGroup(pinnedMessages []*discordgo.Message, lastPoll *discordgo.Message) ([]*discordgo.Message, error) {
+	metadata := parsePollMetadata(lastPoll.Content)
+	if metadata.GroupID == "" {
+		return []*discordgo.Message{lastPoll}, nil
+	}
+	parts := make([]*discordgo.Message, metadata.Parts)
+	for _, pinnedMessage := range pinnedMessages {
+		if pinnedMessage.Poll == nil {
+			continue
+		}
+		partMetadata := parsePollMetadata(pinnedMessage.Content)
+		if partMetadata.GroupID != metadata.GroupID || partMetadata.Part < 1 || partMetadata.Part > len(parts) {
+			continue
+		}
+		parts[partMetadata.Part-1] = pinnedMessage
+	}
+	for i, part := range parts {
+		if part == nil {
+			return nil, fmt.Errorf("could not find part %d/%d of poll group %s", i+1, len(parts), metadata.GroupID)
+		}
+	}
+	return parts, nil
+}
+
+func (d *DefaultService) GetRoleMembers(guildID string, roleID string) ([]string, error) {
+	guildMembers, err := d.getGuildMembers(guildID)
+	if err != nil {
+		return nil, err
+	}
+	var members []string
+	for _, member := range guildMembers {
+		if slices.Contains(member.Roles, roleID) {
+			members = append(members, member.User.ID)
+		}
+	}
+	return members, nil
+}
+
+func (d *DefaultService) GetMemberRoles(guildID string) (map[string][]string, error) {
+	guildMembers, err := d.getGuildMembers(guildID)
+	if err != nil {
+		return nil, err
+	}
+	memberRoles := make(map[string][]string)
+	for _, member := range guildMembers {
+		memberRoles[member.User.ID] = member.Roles
+	}
+	return memberRoles, nil
+}
+
+func (d *DefaultService) getGuildMembers(guildID string) ([]*discordgo.Member, error) {
+	var members []*discordgo.Member
+	after := ""
+	for {
+		page, err := d.client.GuildMembers(guildID, after, maxMembersPerPage)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve guild members: %w", err)
+		}
+		for _, member := range page {
+			if member.User != nil {
+				members = append(members, member)
+			}
+		}
+		if len(page) < maxMembersPerPage || page[len(page)-1].User == nil {
+			return members, nil
+		}
+		after = page[len(page)-1].User.ID
+	}
+}
+
+func (d *DefaultService) getPollVoters(pollMessages []*discordgo.Message) (pollVoters, error) {
+	voters := make(pollVoters)
+	for _, pollMessage := range pollMessages {
+		voters[pollMessage.ID] = make(map[int][]string)
+		if pollMessage.Poll.Results == nil {
+			continue
+		}
+		for _, answerCount := range pollMessage.Poll.Results.AnswerCounts {
+			if answerCount.Count == 0 {
+				continue
+			}
+			answerVoters, err := d.getAnswerVoters(pollMessage.ChannelID, pollMessage.ID, answerCount.ID)
+			if err != nil {
+				return nil, err
+			}
+			voters[pollMessage.ID][answerCount.ID] = answerVoters
+		}
+	}
+	return voters, nil
+}
+
+func (d *DefaultService) getAnswerVoters(channelID string, messageID string, answerID int) ([]string, error) {
+	var voters []string
+	after := ""
+	for {
+		page, err := d.client.PollAnswerVoters(channelID, messageID, answerID, after, maxVotersPerPage)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve voters of answer %d: %w", answerID, err)
+		}
+		for _, user := range page {
+			voters = append(voters, user.ID)
+		}
+		if len(page) < maxVotersPerPage {
+			return voters, nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
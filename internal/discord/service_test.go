@@ -2,11 +2,13 @@ package discord
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/klauspost/lctime"
+	"github.com/paschi/discord-date-decider/internal/event"
 	"github.com/paschi/discord-date-decider/internal/message"
 	"github.com/paschi/discord-date-decider/internal/poll"
 	"github.com/stretchr/testify/assert"
@@ -45,6 +47,11 @@ func (m *MockClient) ChannelMessageUnpin(channelID string, messageID string) err
 	return args.Error(0)
 }
 
+func (m *MockClient) ChannelMessageDelete(channelID string, messageID string) error {
+	args := m.Called(channelID, messageID)
+	return args.Error(0)
+}
+
 func (m *MockClient) ChannelMessagesPinned(channelID string) ([]*discordgo.Message, error) {
 	args := m.Called(channelID)
 	if args.Get(0) == nil {
@@ -58,6 +65,48 @@ func (m *MockClient) ExpirePoll(channelID string, messageID string) error {
 	return args.Error(0)
 }
 
+func (m *MockClient) PollAnswerVoters(channelID string, messageID string, answerID int, after string, limit int) ([]*discordgo.User, error) {
+	args := m.Called(channelID, messageID, answerID, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*discordgo.User), args.Error(1)
+}
+
+func (m *MockClient) GuildMembers(guildID string, after string, limit int) ([]*discordgo.Member, error) {
+	args := m.Called(guildID, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*discordgo.Member), args.Error(1)
+}
+
+func (m *MockClient) GuildScheduledEventCreate(guildID string, params *discordgo.GuildScheduledEventParams) (*discordgo.GuildScheduledEvent, error) {
+	args := m.Called(guildID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*discordgo.GuildScheduledEvent), args.Error(1)
+}
+
+func (m *MockClient) GuildScheduledEventDelete(guildID string, eventID string) error {
+	args := m.Called(guildID, eventID)
+	return args.Error(0)
+}
+
+func (m *MockClient) ChannelMessages(channelID string, limit int) ([]*discordgo.Message, error) {
+	args := m.Called(channelID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*discordgo.Message), args.Error(1)
+}
+
+func (m *MockClient) MessageReactionAdd(channelID string, messageID string, emoji string) error {
+	args := m.Called(channelID, messageID, emoji)
+	return args.Error(0)
+}
+
 func TestNewDefaultService(t *testing.T) {
 	t.Run("successful initialization", func(t *testing.T) {
 		mockClient := new(MockClient)
@@ -156,13 +205,70 @@ func TestDefaultService_SendMessage(t *testing.T) {
 	})
 }
 
+func TestDefaultService_CreateEvent(t *testing.T) {
+	start := time.Date(2025, time.November, 7, 20, 0, 0, 0, time.UTC)
+	scheduledEvent := event.NewEvent("Game Night", "Let's play!", start, 3*time.Hour, "voice-channel-id", "")
+
+	t.Run("successful create event", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GuildScheduledEventCreate", "guild-id", toDiscordScheduledEvent(scheduledEvent)).Return(&discordgo.GuildScheduledEvent{ID: "event-id"}, nil)
+
+		service := NewDefaultService(mockClient)
+		eventID, err := service.CreateEvent("guild-id", scheduledEvent)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "event-id", eventID)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error during create event", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("event error")
+		mockClient.On("GuildScheduledEventCreate", "guild-id", mock.AnythingOfType("*discordgo.GuildScheduledEventParams")).Return(nil, expectedErr)
+
+		service := NewDefaultService(mockClient)
+		eventID, err := service.CreateEvent("guild-id", scheduledEvent)
+
+		assert.Error(t, err)
+		assert.Empty(t, eventID)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDefaultService_DeleteEvent(t *testing.T) {
+	t.Run("successful delete event", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("GuildScheduledEventDelete", "guild-id", "event-id").Return(nil)
+
+		service := NewDefaultService(mockClient)
+		err := service.DeleteEvent("guild-id", "event-id")
+
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error during delete event", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("event error")
+		mockClient.On("GuildScheduledEventDelete", "guild-id", "event-id").Return(expectedErr)
+
+		service := NewDefaultService(mockClient)
+		err := service.DeleteEvent("guild-id", "event-id")
+
+		assert.Error(t, err)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
 func TestDefaultService_SendPoll(t *testing.T) {
 	_ = lctime.SetLocale("en_US")
 	t.Run("successful poll send", func(t *testing.T) {
 		mockClient := new(MockClient)
 		channelID := "test-channel"
 		futureDate := time.Now().AddDate(0, 1, 0)
-		testPoll := poll.NewDatePoll("Test Poll", futureDate.Year(), futureDate.Month(), []time.Weekday{time.Friday, time.Saturday}, time.UTC, []int{}, []int{})
+		testPoll := poll.NewDatePoll("Test Poll", futureDate.Year(), futureDate.Month(), []time.Weekday{time.Friday, time.Saturday}, poll.NewStartTimes(poll.DefaultStartTime, nil), time.UTC, []int{}, []int{}, poll.DateFilter{})
 		discordMessage := &discordgo.Message{ID: "poll-id"}
 		mockClient.On("ChannelMessageSend", channelID, mock.AnythingOfType("*discordgo.MessageSend")).
 			Return(discordMessage, nil)
@@ -179,7 +285,7 @@ func TestDefaultService_SendPoll(t *testing.T) {
 		mockClient := new(MockClient)
 		channelID := "test-channel"
 		futureDate := time.Now().AddDate(0, 1, 0)
-		testPoll := poll.NewDatePoll("Test Poll", futureDate.Year(), futureDate.Month(), []time.Weekday{time.Friday, time.Saturday}, time.UTC, []int{}, []int{})
+		testPoll := poll.NewDatePoll("Test Poll", futureDate.Year(), futureDate.Month(), []time.Weekday{time.Friday, time.Saturday}, poll.NewStartTimes(poll.DefaultStartTime, nil), time.UTC, []int{}, []int{}, poll.DateFilter{})
 		expectedErr := errors.New("send error")
 
 		mockClient.On("ChannelMessageSend", channelID, mock.AnythingOfType("*discordgo.MessageSend")).
@@ -198,7 +304,7 @@ func TestDefaultService_SendPoll(t *testing.T) {
 		mockClient := new(MockClient)
 		channelID := "test-channel"
 		pastDate := time.Now().AddDate(0, -1, 0)
-		testPoll := poll.NewDatePoll("Test Poll", pastDate.Year(), pastDate.Month(), []time.Weekday{time.Friday, time.Saturday}, time.UTC, []int{}, []int{})
+		testPoll := poll.NewDatePoll("Test Poll", pastDate.Year(), pastDate.Month(), []time.Weekday{time.Friday, time.Saturday}, poll.NewStartTimes(poll.DefaultStartTime, nil), time.UTC, []int{}, []int{}, poll.DateFilter{})
 
 		service := NewDefaultService(mockClient)
 		pollID, err := service.SendPoll(channelID, testPoll)
@@ -270,6 +376,66 @@ func TestDefaultService_UnpinPoll(t *testing.T) {
 	})
 }
 
+func TestDefaultService_DeleteMessage(t *testing.T) {
+	t.Run("successful delete message", func(t *testing.T) {
+		mockClient := new(MockClient)
+		channelID := "test-channel"
+		messageID := "message-id"
+		mockClient.On("ChannelMessageDelete", channelID, messageID).Return(nil)
+
+		service := NewDefaultService(mockClient)
+		err := service.DeleteMessage(channelID, messageID)
+
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error during delete message", func(t *testing.T) {
+		mockClient := new(MockClient)
+		channelID := "test-channel"
+		messageID := "message-id"
+		expectedErr := errors.New("delete error")
+		mockClient.On("ChannelMessageDelete", channelID, messageID).Return(expectedErr)
+
+		service := NewDefaultService(mockClient)
+		err := service.DeleteMessage(channelID, messageID)
+
+		assert.Error(t, err)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDefaultService_ExpirePoll(t *testing.T) {
+	t.Run("successful expire poll", func(t *testing.T) {
+		mockClient := new(MockClient)
+		channelID := "test-channel"
+		messageID := "message-id"
+		mockClient.On("ExpirePoll", channelID, messageID).Return(nil)
+
+		service := NewDefaultService(mockClient)
+		err := service.ExpirePoll(channelID, messageID)
+
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error during expire poll", func(t *testing.T) {
+		mockClient := new(MockClient)
+		channelID := "test-channel"
+		messageID := "message-id"
+		expectedErr := errors.New("expire error")
+		mockClient.On("ExpirePoll", channelID, messageID).Return(expectedErr)
+
+		service := NewDefaultService(mockClient)
+		err := service.ExpirePoll(channelID, messageID)
+
+		assert.Error(t, err)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
 func TestDefaultService_GetLastPinnedPollResult(t *testing.T) {
 	location := time.UTC
 
@@ -296,11 +462,11 @@ func TestDefaultService_GetLastPinnedPollResult(t *testing.T) {
 		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{nonPollMsg, pollMsg}, nil)
 
 		service := NewDefaultService(mockClient)
-		result, err := service.GetLastPinnedPollResult(channelID, location)
+		result, err := service.GetLastPinnedPollResult(channelID, location, poll.PluralityStrategy{}, false)
 
 		assert.NoError(t, err)
 		if assert.NotNil(t, result) {
-			assert.Equal(t, "poll-123", result.PollID)
+			assert.Equal(t, []string{"poll-123"}, result.PollIDs)
 			assert.True(t, result.Finalized)
 			expectedDate := time.Date(2025, time.November, 1, 20, 0, 0, 0, location)
 			assert.Len(t, result.WinningAnswers, 1)
@@ -316,7 +482,7 @@ func TestDefaultService_GetLastPinnedPollResult(t *testing.T) {
 		mockClient.On("ChannelMessagesPinned", channelID).Return(([]*discordgo.Message)(nil), expectedErr)
 
 		service := NewDefaultService(mockClient)
-		result, err := service.GetLastPinnedPollResult(channelID, location)
+		result, err := service.GetLastPinnedPollResult(channelID, location, poll.PluralityStrategy{}, false)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -324,13 +490,75 @@ func TestDefaultService_GetLastPinnedPollResult(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 
+	t.Run("successful get last pinned poll result across poll parts", func(t *testing.T) {
+		mockClient := new(MockClient)
+		channelID := "test-channel"
+		part1 := &discordgo.Message{
+			ID:      "part-1",
+			Content: pollMetadata{GroupID: "GROUP", Part: 1, Parts: 2}.String(),
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Friday, 06.11.2026 20:00"}}},
+				Results: &discordgo.PollResults{AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 2}}, Finalized: true},
+			},
+		}
+		part2 := &discordgo.Message{
+			ID:      "part-2",
+			Content: pollMetadata{GroupID: "GROUP", Part: 2, Parts: 2}.String(),
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Saturday, 28.11.2026 20:00"}}},
+				Results: &discordgo.PollResults{AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 4}}, Finalized: true},
+			},
+		}
+		olderPoll := &discordgo.Message{
+			ID:      "older-poll",
+			Content: pollMetadata{GroupID: "OLDER", Part: 1, Parts: 2}.String(),
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Friday, 02.10.2026 20:00"}}},
+				Results: &discordgo.PollResults{AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 9}}, Finalized: true},
+			},
+		}
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{part2, olderPoll, part1}, nil)
+
+		service := NewDefaultService(mockClient)
+		result, err := service.GetLastPinnedPollResult(channelID, location, poll.PluralityStrategy{}, false)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []string{"part-1", "part-2"}, result.PollIDs)
+			assert.Equal(t, []time.Time{time.Date(2026, time.November, 28, 20, 0, 0, 0, location)}, result.WinningAnswers)
+		}
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error when poll part is not pinned", func(t *testing.T) {
+		mockClient := new(MockClient)
+		channelID := "test-channel"
+		part2 := &discordgo.Message{
+			ID:      "part-2",
+			Content: pollMetadata{GroupID: "GROUP", Part: 2, Parts: 2}.String(),
+			Poll: &discordgo.Poll{
+				Answers: []discordgo.PollAnswer{{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Saturday, 28.11.2026 20:00"}}},
+				Results: &discordgo.PollResults{AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 4}}, Finalized: true},
+			},
+		}
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{part2}, nil)
+
+		service := NewDefaultService(mockClient)
+		result, err := service.GetLastPinnedPollResult(channelID, location, poll.PluralityStrategy{}, false)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "could not find part 1/2")
+		mockClient.AssertExpectations(t)
+	})
+
 	t.Run("no pinned poll found", func(t *testing.T) {
 		mockClient := new(MockClient)
 		channelID := "test-channel"
 		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{{ID: "m1"}, {ID: "m2"}}, nil)
 
 		service := NewDefaultService(mockClient)
-		result, err := service.GetLastPinnedPollResult(channelID, location)
+		result, err := service.GetLastPinnedPollResult(channelID, location, poll.PluralityStrategy{}, false)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -338,3 +566,281 @@ func TestDefaultService_GetLastPinnedPollResult(t *testing.T) {
 		mockClient.AssertExpectations(t)
 	})
 }
+
+func TestDefaultService_GetLastPinnedPollResult_WithVoters(t *testing.T) {
+	location := time.UTC
+	friday := time.Date(2025, time.November, 7, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, time.November, 8, 20, 0, 0, 0, time.UTC)
+	metadata := pollMetadata{Answers: []time.Time{friday, saturday}}
+	pollMsg := &discordgo.Message{
+		ID:        "poll-123",
+		ChannelID: "test-channel",
+		Content:   metadata.String(),
+		Poll: &discordgo.Poll{
+			Question: discordgo.PollMedia{Text: "Test Poll"},
+			Answers: []discordgo.PollAnswer{
+				{AnswerID: 1, Media: &discordgo.PollMedia{Text: "Friday"}},
+				{AnswerID: 2, Media: &discordgo.PollMedia{Text: "Saturday"}},
+			},
+			Results: &discordgo.PollResults{
+				AnswerCounts: []*discordgo.PollAnswerCount{{ID: 1, Count: 2}, {ID: 2, Count: 1}},
+				Finalized:    true,
+			},
+		},
+	}
+
+	t.Run("successful exclusion of dates missing required members", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("ChannelMessagesPinned", "test-channel").Return([]*discordgo.Message{pollMsg}, nil)
+		mockClient.On("PollAnswerVoters", "test-channel", "poll-123", 1, "", maxVotersPerPage).Return([]*discordgo.User{{ID: "alice"}, {ID: "bob"}}, nil)
+		mockClient.On("PollAnswerVoters", "test-channel", "poll-123", 2, "", maxVotersPerPage).Return([]*discordgo.User{{ID: "carol"}}, nil)
+
+		service := NewDefaultService(mockClient)
+		result, err := service.GetLastPinnedPollResult("test-channel", location, poll.RequiredMembersStrategy{Members: []string{"carol"}}, false)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []time.Time{saturday}, result.WinningAnswers)
+			assert.Equal(t, 1.0, result.WinningScore)
+		}
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("successful fetch of voters when requested", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("ChannelMessagesPinned", "test-channel").Return([]*discordgo.Message{pollMsg}, nil)
+		mockClient.On("PollAnswerVoters", "test-channel", "poll-123", 1, "", maxVotersPerPage).Return([]*discordgo.User{{ID: "alice"}, {ID: "bob"}}, nil)
+		mockClient.On("PollAnswerVoters", "test-channel", "poll-123", 2, "", maxVotersPerPage).Return([]*discordgo.User{{ID: "carol"}}, nil)
+
+		service := NewDefaultService(mockClient)
+		result, err := service.GetLastPinnedPollResult("test-channel", location, poll.PluralityStrategy{}, true)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, result) {
+			assert.Equal(t, []time.Time{friday}, result.WinningAnswers)
+			assert.Equal(t, []string{"alice", "bob"}, result.VotersOf(friday))
+		}
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error when retrieving voters", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("voters error")
+		mockClient.On("ChannelMessagesPinned", "test-channel").Return([]*discordgo.Message{pollMsg}, nil)
+		mockClient.On("PollAnswerVoters", "test-channel", "poll-123", 1, "", maxVotersPerPage).Return(nil, expectedErr)
+
+		service := NewDefaultService(mockClient)
+		result, err := service.GetLastPinnedPollResult("test-channel", location, poll.RequiredMembersStrategy{Members: []string{"carol"}}, false)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, expectedErr)
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDefaultService_GetRoleMembers(t *testing.T) {
+	t.Run("successful get role members across pages", func(t *testing.T) {
+		mockClient := new(MockClient)
+		firstPage := make([]*discordgo.Member, maxMembersPerPage)
+		for i := range firstPage {
+			firstPage[i] = &discordgo.Member{User: &discordgo.User{ID: fmt.Sprintf("user-%d", i)}}
+		}
+		firstPage[0].Roles = []string{"role-id"}
+		secondPage := []*discordgo.Member{
+			{User: &discordgo.User{ID: "alice"}, Roles: []string{"other-role", "role-id"}},
+			{User: &discordgo.User{ID: "bob"}, Roles: []string{"other-role"}},
+		}
+		mockClient.On("GuildMembers", "guild-id", "", maxMembersPerPage).Return(firstPage, nil)
+		mockClient.On("GuildMembers", "guild-id", fmt.Sprintf("user-%d", maxMembersPerPage-1), maxMembersPerPage).Return(secondPage, nil)
+
+		service := NewDefaultService(mockClient)
+		members, err := service.GetRoleMembers("guild-id", "role-id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"user-0", "alice"}, members)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error when retrieving guild members", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("members error")
+		mockClient.On("GuildMembers", "guild-id", "", maxMembersPerPage).Return(nil, expectedErr)
+
+		service := NewDefaultService(mockClient)
+		members, err := service.GetRoleMembers("guild-id", "role-id")
+
+		assert.Error(t, err)
+		assert.Nil(t, members)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDefaultService_GetMemberRoles(t *testing.T) {
+	t.Run("successful get member roles", func(t *testing.T) {
+		mockClient := new(MockClient)
+		page := []*discordgo.Member{
+			{User: &discordgo.User{ID: "alice"}, Roles: []string{"core-role"}},
+			{User: &discordgo.User{ID: "bob"}},
+			{Roles: []string{"core-role"}},
+		}
+		mockClient.On("GuildMembers", "guild-id", "", maxMembersPerPage).Return(page, nil)
+
+		service := NewDefaultService(mockClient)
+		memberRoles, err := service.GetMemberRoles("guild-id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{"alice": {"core-role"}, "bob": nil}, memberRoles)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error when retrieving guild members", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("members error")
+		mockClient.On("GuildMembers", "guild-id", "", maxMembersPerPage).Return(nil, expectedErr)
+
+		service := NewDefaultService(mockClient)
+		memberRoles, err := service.GetMemberRoles("guild-id")
+
+		assert.Error(t, err)
+		assert.Nil(t, memberRoles)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDefaultService_FindPolls(t *testing.T) {
+	channelID := "test-channel"
+	announced := &discordgo.MessageReactions{Me: true, Emoji: &discordgo.Emoji{Name: announcedReaction}}
+	pollMessage := func(id string, metadata pollMetadata, reactions ...*discordgo.MessageReactions) *discordgo.Message {
+		return &discordgo.Message{ID: id, Content: metadata.String(), Poll: &discordgo.Poll{}, Reactions: reactions}
+	}
+
+	t.Run("successful find of posted poll parts", func(t *testing.T) {
+		mockClient := new(MockClient)
+		part1 := pollMessage("part-1", pollMetadata{GroupID: "new", Part: 1, Parts: 2, Period: "2026-11"}, announced)
+		part2 := pollMessage("part-2", pollMetadata{GroupID: "new", Part: 2, Parts: 2, Period: "2026-11"})
+		otherGroup := pollMessage("other-group", pollMetadata{GroupID: "old", Part: 1, Parts: 2, Period: "2026-11"})
+		otherPeriod := pollMessage("other-period", pollMetadata{Period: "2026-10"})
+		otherProfile := pollMessage("other-profile", pollMetadata{Period: "2026-11", Profile: "other"})
+		runoff := pollMessage("runoff", pollMetadata{Kind: pollKindRunoff, Period: "2026-11"})
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{part1, otherPeriod}, nil)
+		mockClient.On("ChannelMessages", channelID, maxRecentMessages).Return([]*discordgo.Message{runoff, part2, part1, otherProfile, otherGroup, {ID: "no-poll"}}, nil)
+
+		service := NewDefaultService(mockClient)
+		postedPolls, err := service.FindPolls(channelID, "2026-11", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []poll.PostedPoll{
+			{ID: "part-1", GroupID: "new", Part: 1, Pinned: true, Announced: true},
+			{ID: "part-2", GroupID: "new", Part: 2, Pinned: false, Announced: false},
+		}, postedPolls)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("successful find of pinned poll reported by recent messages", func(t *testing.T) {
+		mockClient := new(MockClient)
+		pinnedPart := pollMessage("part-1", pollMetadata{Period: "2026-11"})
+		recentPart := pollMessage("part-1", pollMetadata{Period: "2026-11"})
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{pinnedPart}, nil)
+		mockClient.On("ChannelMessages", channelID, maxRecentMessages).Return([]*discordgo.Message{recentPart}, nil)
+
+		service := NewDefaultService(mockClient)
+		postedPolls, err := service.FindPolls(channelID, "2026-11", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []poll.PostedPoll{{ID: "part-1", Pinned: true}}, postedPolls)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("successful find skips ended polls", func(t *testing.T) {
+		mockClient := new(MockClient)
+		pastDate := time.Now().AddDate(0, -1, 0)
+		futureDate := time.Now().AddDate(0, 1, 0)
+		finalized := pollMessage("finalized", pollMetadata{GroupID: "old", Part: 1, Parts: 1, Period: "2026-11"})
+		finalized.Poll.Results = &discordgo.PollResults{Finalized: true}
+		expired := pollMessage("expired", pollMetadata{GroupID: "old", Part: 1, Parts: 1, Period: "2026-11"})
+		expired.Poll.Expiry = &pastDate
+		open := pollMessage("open", pollMetadata{GroupID: "new", Part: 1, Parts: 1, Period: "2026-11"})
+		open.Poll.Expiry = &futureDate
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{finalized, expired}, nil)
+		mockClient.On("ChannelMessages", channelID, maxRecentMessages).Return([]*discordgo.Message{open}, nil)
+
+		service := NewDefaultService(mockClient)
+		postedPolls, err := service.FindPolls(channelID, "2026-11", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []poll.PostedPoll{{ID: "open", GroupID: "new", Part: 1}}, postedPolls)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("successful find without open polls", func(t *testing.T) {
+		mockClient := new(MockClient)
+		finalized := pollMessage("finalized", pollMetadata{Period: "2026-11"})
+		finalized.Poll.Results = &discordgo.PollResults{Finalized: true}
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{finalized}, nil)
+		mockClient.On("ChannelMessages", channelID, maxRecentMessages).Return([]*discordgo.Message{finalized}, nil)
+
+		service := NewDefaultService(mockClient)
+		postedPolls, err := service.FindPolls(channelID, "2026-11", "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, postedPolls)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("successful find without posted polls", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{}, nil)
+		mockClient.On("ChannelMessages", channelID, maxRecentMessages).Return([]*discordgo.Message{}, nil)
+
+		service := NewDefaultService(mockClient)
+		postedPolls, err := service.FindPolls(channelID, "2026-11", "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, postedPolls)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error when retrieving recent messages", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("messages error")
+		mockClient.On("ChannelMessagesPinned", channelID).Return([]*discordgo.Message{}, nil)
+		mockClient.On("ChannelMessages", channelID, maxRecentMessages).Return(nil, expectedErr)
+
+		service := NewDefaultService(mockClient)
+		postedPolls, err := service.FindPolls(channelID, "2026-11", "")
+
+		assert.Error(t, err)
+		assert.Nil(t, postedPolls)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
+
+func TestDefaultService_MarkPollAnnounced(t *testing.T) {
+	t.Run("successful mark poll announced", func(t *testing.T) {
+		mockClient := new(MockClient)
+		mockClient.On("MessageReactionAdd", "test-channel", "poll-id", announcedReaction).Return(nil)
+
+		service := NewDefaultService(mockClient)
+		err := service.MarkPollAnnounced("test-channel", "poll-id")
+
+		assert.NoError(t, err)
+		mockClient.AssertExpectations(t)
+	})
+
+	t.Run("error during mark poll announced", func(t *testing.T) {
+		mockClient := new(MockClient)
+		expectedErr := errors.New("reaction error")
+		mockClient.On("MessageReactionAdd", "test-channel", "poll-id", announcedReaction).Return(expectedErr)
+
+		service := NewDefaultService(mockClient)
+		err := service.MarkPollAnnounced("test-channel", "poll-id")
+
+		assert.Error(t, err)
+		assert.Equal(t, expectedErr, errors.Unwrap(err))
+		mockClient.AssertExpectations(t)
+	})
+}
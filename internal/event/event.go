@@ -0,0 +1,28 @@
+package event
+
+import "time"
+
+type Event struct {
+	Name        string
+	Description string
+	Start       time.Time
+	End         time.Time
+	// voice channel of the event, empty for events at an external location
+	ChannelID string
+	Location  string
+}
+
+func NewEvent(name string, description string, start time.Time, duration time.Duration, channelID string, location string) *Event {
+	return &Event{
+		Name:        name,
+		Description: description,
+		Start:       start,
+		End:         start.Add(duration),
+		ChannelID:   channelID,
+		Location:    location,
+	}
+}
+
+func (e *Event) IsExternal() bool {
+	return e.ChannelID == ""
+}
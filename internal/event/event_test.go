@@ -0,0 +1,44 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEvent(t *testing.T) {
+	start := time.Date(2025, time.November, 7, 20, 0, 0, 0, time.UTC)
+	parameters := []struct {
+		name             string
+		channelID        string
+		location         string
+		expectedExternal bool
+	}{
+		{
+			name:             "event in voice channel",
+			channelID:        "voice-channel-id",
+			expectedExternal: false,
+		},
+		{
+			name:             "event at external location",
+			location:         "Game Store",
+			expectedExternal: true,
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			e := NewEvent("Game Night", "Let's play!", start, 3*time.Hour, parameter.channelID, parameter.location)
+
+			assert.NotNil(t, e)
+			assert.Equal(t, "Game Night", e.Name)
+			assert.Equal(t, "Let's play!", e.Description)
+			assert.Equal(t, start, e.Start)
+			assert.Equal(t, time.Date(2025, time.November, 7, 23, 0, 0, 0, time.UTC), e.End)
+			assert.Equal(t, parameter.channelID, e.ChannelID)
+			assert.Equal(t, parameter.location, e.Location)
+			assert.Equal(t, parameter.expectedExternal, e.IsExternal())
+		})
+	}
+}
@@ -3,6 +3,8 @@ package message
 type Message struct {
 	Content          string
 	MentionsEveryone bool
+	// only these users are notified, other user mentions in the content stay silent
+	MentionUserIDs []string
 }
 
 func NewMessage(content string, mentionsEveryone bool) *Message {
@@ -11,3 +13,10 @@ func NewMessage(content string, mentionsEveryone bool) *Message {
 		MentionsEveryone: mentionsEveryone,
 	}
 }
+
+func NewUserMentionMessage(content string, userIDs []string) *Message {
+	return &Message{
+		Content:        content,
+		MentionUserIDs: userIDs,
+	}
+}
@@ -34,3 +34,12 @@ func TestNewMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUserMentionMessage(t *testing.T) {
+	msg := NewUserMentionMessage("<@alice> <@bob> testMessage", []string{"alice", "bob"})
+
+	assert.NotNil(t, msg)
+	assert.Equal(t, "<@alice> <@bob> testMessage", msg.Content)
+	assert.False(t, msg.MentionsEveryone)
+	assert.Equal(t, []string{"alice", "bob"}, msg.MentionUserIDs)
+}
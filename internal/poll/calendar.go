@@ -0,0 +1,241 @@
+package poll
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+const bundledCalendarPrefix = "bundled:"
+
+var errUnsupportedRecurrence = errors.New("unsupported recurrence")
+
+//go:embed calendars/*.ics
+var bundledCalendars embed.FS
+
+type Calendar struct {
+	Events []CalendarEvent
+	// Warnings describes events that were only partially understood
+	Warnings []string
+}
+
+type CalendarEvent struct {
+	Summary  string
+	FirstDay time.Time
+	Days     int
+	Rule     *Rule
+}
+
+func LoadCalendar(source string, location *time.Location) (*Calendar, error) {
+	var file io.ReadCloser
+	var err error
+	if name, found := strings.CutPrefix(source, bundledCalendarPrefix); found {
+		file, err = bundledCalendars.Open("calendars/" + name + ".ics")
+	} else {
+		file, err = os.Open(source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open calendar '%s': %w", source, err)
+	}
+	defer file.Close()
+	calendar, err := ParseCalendar(file, location)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse calendar '%s': %w", source, err)
+	}
+	return calendar, nil
+}
+
+func ParseCalendar(reader io.Reader, location *time.Location) (*Calendar, error) {
+	lines, err := unfoldLines(reader)
+	if err != nil {
+		return nil, err
+	}
+	calendar := &Calendar{}
+	var properties map[string]calendarProperty
+	for _, line := range lines {
+		name, property, err := parseCalendarProperty(line)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case name == "BEGIN" && property.value == "VEVENT":
+			properties = make(map[string]calendarProperty)
+		case name == "END" && property.value == "VEVENT":
+			if properties == nil {
+				return nil, fmt.Errorf("unexpected end of event")
+			}
+			event, err := newCalendarEvent(properties, location)
+			if errors.Is(err, errUnsupportedRecurrence) {
+				calendar.Warnings = append(calendar.Warnings, err.Error())
+			} else if err != nil {
+				return nil, err
+			}
+			calendar.Events = append(calendar.Events, event)
+			properties = nil
+		case properties != nil:
+			properties[name] = property
+		}
+	}
+	if properties != nil {
+		return nil, fmt.Errorf("unterminated event")
+	}
+	return calendar, nil
+}
+
+func (c *Calendar) Matches(date time.Time) bool {
+	return c.EventOn(date) != nil
+}
+
+func (c *Calendar) EventOn(date time.Time) *CalendarEvent {
+	for i := range c.Events {
+		if c.Events[i].Matches(date) {
+			return &c.Events[i]
+		}
+	}
+	return nil
+}
+
+func (e CalendarEvent) Matches(date time.Time) bool {
+	day := civilDate(date)
+	for offset := 0; offset < e.Days; offset++ {
+		eventDay := day.AddDate(0, 0, -offset)
+		if e.Rule == nil && eventDay.Equal(e.FirstDay) {
+			return true
+		}
+		if e.Rule != nil && e.Rule.Matches(eventDay) {
+			return true
+		}
+	}
+	return false
+}
+
+type calendarProperty struct {
+	parameters map[string]string
+	value      string
+}
+
+func unfoldLines(reader io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+func parseCalendarProperty(line string) (string, calendarProperty, error) {
+	head, value, found := strings.Cut(line, ":")
+	if !found {
+		return "", calendarProperty{}, fmt.Errorf("invalid calendar line: %s", line)
+	}
+	parts := strings.Split(head, ";")
+	property := calendarProperty{parameters: make(map[string]string), value: value}
+	for _, parameter := range parts[1:] {
+		key, parameterValue, _ := strings.Cut(parameter, "=")
+		property.parameters[strings.ToUpper(key)] = strings.Trim(parameterValue, `"`)
+	}
+	return strings.ToUpper(parts[0]), property, nil
+}
+
+func newCalendarEvent(properties map[string]calendarProperty, location *time.Location) (CalendarEvent, error) {
+	summary := properties["SUMMARY"].value
+	start, found := properties["DTSTART"]
+	if !found {
+		return CalendarEvent{}, fmt.Errorf("event '%s' has no start", summary)
+	}
+	firstDay, startIsDate, err := parseCalendarDate(start, location)
+	if err != nil {
+		return CalendarEvent{}, fmt.Errorf("event '%s' has an invalid start: %w", summary, err)
+	}
+	days := 1
+	if end, found := properties["DTEND"]; found {
+		lastDay, endIsDate, err := parseCalendarDate(end, location)
+		if err != nil {
+			return CalendarEvent{}, fmt.Errorf("event '%s' has an invalid end: %w", summary, err)
+		}
+		// all-day events end exclusively, timed events cover their last day unless they end at midnight
+		if endIsDate || (!startIsDate && isMidnight(end.value)) {
+			lastDay = lastDay.AddDate(0, 0, -1)
+		}
+		days = max(1, int(lastDay.Sub(firstDay).Hours()/24)+1)
+	}
+	event := CalendarEvent{Summary: summary, FirstDay: firstDay, Days: days}
+	if recurrence, found := properties["RRULE"]; found {
+		rule, err := ParseRule(completeRecurrence(recurrence.value, firstDay))
+		if err != nil {
+			// feeds often use recurrences that rules do not support, so the event keeps at least its first occurrence
+			return event, fmt.Errorf("%w of event '%s', only its first occurrence is used: %w", errUnsupportedRecurrence, summary, err)
+		}
+		event.Rule = rule
+	}
+	return event, nil
+}
+
+func parseCalendarDate(property calendarProperty, location *time.Location) (time.Time, bool, error) {
+	value := property.value
+	if property.parameters["VALUE"] == "DATE" || len(value) == 8 {
+		date, err := time.Parse("20060102", value)
+		return date, true, err
+	}
+	valueLocation := location
+	if strings.HasSuffix(value, "Z") {
+		valueLocation = time.UTC
+		value = strings.TrimSuffix(value, "Z")
+	} else if timeZone, found := property.parameters["TZID"]; found {
+		var err error
+		valueLocation, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	dateTime, err := time.ParseInLocation("20060102T150405", value, valueLocation)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return civilDate(dateTime.In(location)), false, nil
+}
+
+func isMidnight(value string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(value, "Z"), "T000000")
+}
+
+// completeRecurrence adds the parts that iCalendar derives from the event start, but which rules require explicitly
+func completeRecurrence(recurrence string, firstDay time.Time) string {
+	upper := strings.ToUpper(recurrence)
+	hasDay := strings.Contains(upper, "BYDAY=") || strings.Contains(upper, "BYMONTHDAY=")
+	hasMonth := strings.Contains(upper, "BYMONTH=")
+	derived := recurrence
+	switch {
+	case strings.Contains(upper, "FREQ=YEARLY"):
+		if !hasDay && !hasMonth {
+			derived += fmt.Sprintf(";BYMONTH=%d", firstDay.Month())
+		}
+		if !hasDay {
+			derived += fmt.Sprintf(";BYMONTHDAY=%d", firstDay.Day())
+		}
+	case strings.Contains(upper, "FREQ=MONTHLY"):
+		if !hasDay {
+			derived += fmt.Sprintf(";BYMONTHDAY=%d", firstDay.Day())
+		}
+	case strings.Contains(upper, "FREQ=WEEKLY"):
+		if !strings.Contains(upper, "BYDAY=") {
+			derived += ";BYDAY=" + strings.ToUpper(firstDay.Weekday().String()[:2])
+		}
+	}
+	return derived + ";DTSTART=" + firstDay.Format("20060102")
+}
@@ -0,0 +1,186 @@
+package poll
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCalendar(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20261003",
+		"SUMMARY:Tag der Deutschen",
+		"  Einheit",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20261221",
+		"DTEND;VALUE=DATE:20261224",
+		"SUMMARY:Blackout",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=Europe/Berlin:20261106T180000",
+		"DTEND;TZID=Europe/Berlin:20261107T020000",
+		"SUMMARY:Concert",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20261113T230000Z",
+		"SUMMARY:Late night",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20000501",
+		"RRULE:FREQ=YEARLY",
+		"SUMMARY:Tag der Arbeit",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20260525",
+		"RRULE:FREQ=YEARLY;BYMONTH=5;BYDAY=-1MO",
+		"SUMMARY:Memorial Day",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	calendar, err := ParseCalendar(strings.NewReader(ics), berlin)
+
+	if !assert.NoError(t, err) || !assert.Len(t, calendar.Events, 6) {
+		return
+	}
+	assert.Equal(t, "Tag der Deutschen Einheit", calendar.Events[0].Summary)
+	parameters := []struct {
+		name            string
+		date            time.Time
+		expectedSummary string
+	}{
+		{name: "single all-day event", date: time.Date(2026, 10, 3, 20, 0, 0, 0, berlin), expectedSummary: "Tag der Deutschen Einheit"},
+		{name: "first day of multi-day event", date: time.Date(2026, 12, 21, 20, 0, 0, 0, berlin), expectedSummary: "Blackout"},
+		{name: "last day of multi-day event", date: time.Date(2026, 12, 23, 20, 0, 0, 0, berlin), expectedSummary: "Blackout"},
+		{name: "exclusive end of multi-day event", date: time.Date(2026, 12, 24, 20, 0, 0, 0, berlin)},
+		{name: "start of timed event", date: time.Date(2026, 11, 6, 20, 0, 0, 0, berlin), expectedSummary: "Concert"},
+		{name: "end of timed event after midnight", date: time.Date(2026, 11, 7, 20, 0, 0, 0, berlin), expectedSummary: "Concert"},
+		{name: "utc event in local time zone", date: time.Date(2026, 11, 14, 20, 0, 0, 0, berlin), expectedSummary: "Late night"},
+		{name: "utc event not on utc day", date: time.Date(2026, 11, 13, 20, 0, 0, 0, berlin)},
+		{name: "yearly recurrence", date: time.Date(2027, 5, 1, 20, 0, 0, 0, berlin), expectedSummary: "Tag der Arbeit"},
+		{name: "yearly recurrence with weekday", date: time.Date(2027, 5, 31, 20, 0, 0, 0, berlin), expectedSummary: "Memorial Day"},
+		{name: "yearly recurrence before start", date: time.Date(2025, 5, 26, 20, 0, 0, 0, berlin)},
+		{name: "no event", date: time.Date(2026, 10, 2, 20, 0, 0, 0, berlin)},
+	}
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			event := calendar.EventOn(parameter.date)
+
+			if parameter.expectedSummary == "" {
+				assert.Nil(t, event)
+				assert.False(t, calendar.Matches(parameter.date))
+			} else if assert.NotNil(t, event) {
+				assert.Equal(t, parameter.expectedSummary, event.Summary)
+				assert.True(t, calendar.Matches(parameter.date))
+			}
+		})
+	}
+}
+
+func TestParseCalendar_Errors(t *testing.T) {
+	parameters := []struct {
+		name string
+		ics  string
+	}{
+		{name: "event without start", ics: "BEGIN:VEVENT\nSUMMARY:Nothing\nEND:VEVENT"},
+		{name: "event with invalid start", ics: "BEGIN:VEVENT\nDTSTART:tomorrow\nEND:VEVENT"},
+		{name: "event with invalid end", ics: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261003\nDTEND;VALUE=DATE:someday\nEND:VEVENT"},
+		{name: "event with unknown time zone", ics: "BEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20261003T200000\nEND:VEVENT"},
+		{name: "unterminated event", ics: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20261003"},
+		{name: "unexpected end of event", ics: "END:VEVENT"},
+		{name: "invalid line", ics: "BEGIN:VEVENT\nDTSTART;VALUE=DATE\nEND:VEVENT"},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			calendar, err := ParseCalendar(strings.NewReader(parameter.ics), time.UTC)
+
+			assert.Error(t, err)
+			assert.Nil(t, calendar)
+		})
+	}
+}
+
+func TestParseCalendar_UnsupportedRecurrence(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20261003",
+		"RRULE:FREQ=DAILY;COUNT=3",
+		"SUMMARY:Festival",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20261225",
+		"RRULE:FREQ=YEARLY;COUNT=5",
+		"SUMMARY:Christmas",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20261231",
+		"SUMMARY:New Year's Eve",
+		"END:VEVENT",
+	}, "\n")
+
+	calendar, err := ParseCalendar(strings.NewReader(ics), time.UTC)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, calendar.Events, 3)
+	assert.Len(t, calendar.Warnings, 2)
+	assert.Contains(t, calendar.Warnings[0], "Festival")
+	assert.True(t, calendar.Matches(time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)))
+	assert.False(t, calendar.Matches(time.Date(2026, 10, 4, 20, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.Matches(time.Date(2026, 12, 25, 20, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.Matches(time.Date(2026, 12, 31, 20, 0, 0, 0, time.UTC)))
+}
+
+func TestLoadCalendar(t *testing.T) {
+	t.Run("bundled calendar", func(t *testing.T) {
+		calendar, err := LoadCalendar("bundled:de-fixed-holidays", time.UTC)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, calendar) {
+			assert.True(t, calendar.Matches(time.Date(2026, 12, 26, 20, 0, 0, 0, time.UTC)))
+			assert.False(t, calendar.Matches(time.Date(2026, 12, 27, 20, 0, 0, 0, time.UTC)))
+		}
+	})
+
+	t.Run("local calendar", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "blackout.ics")
+		err := os.WriteFile(path, []byte("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20261106\nSUMMARY:Blackout\nEND:VEVENT\nEND:VCALENDAR\n"), 0o600)
+		assert.NoError(t, err)
+
+		calendar, err := LoadCalendar(path, time.UTC)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, calendar) {
+			assert.True(t, calendar.Matches(time.Date(2026, 11, 6, 20, 0, 0, 0, time.UTC)))
+		}
+	})
+
+	t.Run("error missing calendar", func(t *testing.T) {
+		calendar, err := LoadCalendar(filepath.Join(t.TempDir(), "missing.ics"), time.UTC)
+
+		assert.Error(t, err)
+		assert.Nil(t, calendar)
+	})
+
+	t.Run("error invalid calendar", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "invalid.ics")
+		err := os.WriteFile(path, []byte("BEGIN:VEVENT\n"), 0o600)
+		assert.NoError(t, err)
+
+		calendar, err := LoadCalendar(path, time.UTC)
+
+		assert.Error(t, err)
+		assert.Nil(t, calendar)
+	})
+}
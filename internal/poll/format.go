@@ -0,0 +1,83 @@
+package poll
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/klauspost/lctime"
+)
+
+const (
+	maxAnswerLength     = 55
+	defaultAnswerFormat = "%A, %d.%m.%Y %H:%M"
+	holidayFlag         = "🎉"
+)
+
+const (
+	AnswerFormatLong  = "long"
+	AnswerFormatShort = "short"
+	AnswerFormatISO   = "iso"
+)
+
+// presets fall back from the full locale to its language and finally to the empty key
+var answerFormatPresets = map[string]map[string]string{
+	AnswerFormatLong: {
+		"":      "%A, %x %H:%M",
+		"en_US": "%A, %x %I:%M %p",
+		"en_CA": "%A, %x %I:%M %p",
+		"en_AU": "%A, %x %I:%M %p",
+	},
+	AnswerFormatShort: {
+		"":      "%a, %d.%m. %H:%M",
+		"en":    "%a, %d/%m %H:%M",
+		"en_US": "%a, %m/%d %I:%M %p",
+		"en_CA": "%a, %m/%d %I:%M %p",
+		"fr":    "%a %d/%m %H:%M",
+	},
+	AnswerFormatISO: {
+		"": "%Y-%m-%d %H:%M",
+	},
+}
+
+func ResolveAnswerFormat(format string, locale string) (string, error) {
+	if format == "" {
+		format = AnswerFormatLong
+	}
+	presets, ok := answerFormatPresets[strings.ToLower(format)]
+	if !ok {
+		if !strings.Contains(format, "%") {
+			return "", fmt.Errorf("answer format must be a preset (long, short, iso) or a strftime layout: %s", format)
+		}
+		return format, nil
+	}
+	language, _, _ := strings.Cut(locale, "_")
+	for _, key := range []string{locale, language, ""} {
+		if layout, ok := presets[key]; ok {
+			return layout, nil
+		}
+	}
+	return defaultAnswerFormat, nil
+}
+
+func (p *DatePoll) Label(answer time.Time) string {
+	layout := p.AnswerFormat
+	if layout == "" {
+		layout = defaultAnswerFormat
+	}
+	label := strings.TrimSpace(lctime.Strftime(layout, answer))
+	if p.IsFlagged(answer) {
+		label += " " + holidayFlag
+	}
+	return label
+}
+
+func (p *DatePoll) ValidateLabels() error {
+	for _, answer := range p.Answers {
+		if label := p.Label(answer); utf8.RuneCountInString(label) > maxAnswerLength {
+			return fmt.Errorf("answer '%s' exceeds %d characters", label, maxAnswerLength)
+		}
+	}
+	return nil
+}
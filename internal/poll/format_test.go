@@ -0,0 +1,84 @@
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/klauspost/lctime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveAnswerFormat(t *testing.T) {
+	parameters := []struct {
+		name           string
+		format         string
+		locale         string
+		expectedLayout string
+		expectError    bool
+	}{
+		{name: "default for us locale", locale: "en_US", expectedLayout: "%A, %x %I:%M %p"},
+		{name: "default for german locale", locale: "de_DE", expectedLayout: "%A, %x %H:%M"},
+		{name: "short preset by language", format: "short", locale: "en_GB", expectedLayout: "%a, %d/%m %H:%M"},
+		{name: "short preset fallback", format: "Short", locale: "de_DE", expectedLayout: "%a, %d.%m. %H:%M"},
+		{name: "iso preset", format: "iso", locale: "en_US", expectedLayout: "%Y-%m-%d %H:%M"},
+		{name: "strftime layout", format: "%d.%m. %H:%M", locale: "en_US", expectedLayout: "%d.%m. %H:%M"},
+		{name: "unknown preset", format: "medium", locale: "en_US", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			layout, err := ResolveAnswerFormat(parameter.format, parameter.locale)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedLayout, layout)
+			}
+		})
+	}
+}
+
+func TestDatePoll_Label(t *testing.T) {
+	answer := time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)
+	parameters := []struct {
+		name          string
+		locale        string
+		format        string
+		flagged       bool
+		expectedLabel string
+	}{
+		{name: "default format", locale: "en_US", expectedLabel: "Saturday, 03.10.2026 20:00"},
+		{name: "us long format", locale: "en_US", format: "long", expectedLabel: "Saturday, 10/03/2026 08:00 PM"},
+		{name: "german long format", locale: "de_DE", format: "long", expectedLabel: "Samstag, 03.10.2026 20:00"},
+		{name: "us short format", locale: "en_US", format: "short", expectedLabel: "Sat, 10/03 08:00 PM"},
+		{name: "iso format with holiday", locale: "en_US", format: "iso", flagged: true, expectedLabel: "2026-10-03 20:00 🎉"},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			_ = lctime.SetLocale(parameter.locale)
+			datePoll := &DatePoll{Answers: []time.Time{answer}}
+			if parameter.format != "" {
+				datePoll.AnswerFormat, _ = ResolveAnswerFormat(parameter.format, parameter.locale)
+			}
+			if parameter.flagged {
+				datePoll.Flagged = []time.Time{answer}
+			}
+
+			assert.Equal(t, parameter.expectedLabel, datePoll.Label(answer))
+			assert.NoError(t, datePoll.ValidateLabels())
+		})
+	}
+	_ = lctime.SetLocale("en_US")
+}
+
+func TestDatePoll_ValidateLabels(t *testing.T) {
+	_ = lctime.SetLocale("en_US")
+	datePoll := &DatePoll{
+		Answers:      []time.Time{time.Date(2026, 9, 30, 20, 0, 0, 0, time.UTC)},
+		AnswerFormat: "%A, %B %d %Y at %H:%M o'clock, please vote for this date",
+	}
+
+	assert.Error(t, datePoll.ValidateLabels())
+}
@@ -0,0 +1,173 @@
+package poll
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Holiday struct {
+	Name string
+	Date time.Time
+}
+
+type HolidayCalendar struct {
+	Country     string
+	Subdivision string
+	definitions []holidayDefinition
+}
+
+type holidayDefinition struct {
+	name         string
+	date         func(year int) time.Time
+	subdivisions []string
+}
+
+var holidayDefinitions = map[string][]holidayDefinition{
+	"DE": {
+		{name: "Neujahr", date: fixedDate(time.January, 1)},
+		{name: "Heilige Drei Könige", date: fixedDate(time.January, 6), subdivisions: []string{"BW", "BY", "ST"}},
+		{name: "Internationaler Frauentag", date: fixedDate(time.March, 8), subdivisions: []string{"BE", "MV"}},
+		{name: "Karfreitag", date: easterOffset(-2)},
+		{name: "Ostersonntag", date: easterOffset(0), subdivisions: []string{"BB"}},
+		{name: "Ostermontag", date: easterOffset(1)},
+		{name: "Tag der Arbeit", date: fixedDate(time.May, 1)},
+		{name: "Christi Himmelfahrt", date: easterOffset(39)},
+		{name: "Pfingstsonntag", date: easterOffset(49), subdivisions: []string{"BB"}},
+		{name: "Pfingstmontag", date: easterOffset(50)},
+		{name: "Fronleichnam", date: easterOffset(60), subdivisions: []string{"BW", "BY", "HE", "NW", "RP", "SL"}},
+		{name: "Mariä Himmelfahrt", date: fixedDate(time.August, 15), subdivisions: []string{"SL"}},
+		{name: "Weltkindertag", date: fixedDate(time.September, 20), subdivisions: []string{"TH"}},
+		{name: "Tag der Deutschen Einheit", date: fixedDate(time.October, 3)},
+		{name: "Reformationstag", date: fixedDate(time.October, 31), subdivisions: []string{"BB", "HB", "HH", "MV", "NI", "SN", "ST", "SH", "TH"}},
+		{name: "Allerheiligen", date: fixedDate(time.November, 1), subdivisions: []string{"BW", "BY", "NW", "RP", "SL"}},
+		{name: "Buß- und Bettag", date: repentanceDay, subdivisions: []string{"SN"}},
+		{name: "1. Weihnachtstag", date: fixedDate(time.December, 25)},
+		{name: "2. Weihnachtstag", date: fixedDate(time.December, 26)},
+	},
+	"AT": {
+		{name: "Neujahr", date: fixedDate(time.January, 1)},
+		{name: "Heilige Drei Könige", date: fixedDate(time.January, 6)},
+		{name: "Ostermontag", date: easterOffset(1)},
+		{name: "Staatsfeiertag", date: fixedDate(time.May, 1)},
+		{name: "Christi Himmelfahrt", date: easterOffset(39)},
+		{name: "Pfingstmontag", date: easterOffset(50)},
+		{name: "Fronleichnam", date: easterOffset(60)},
+		{name: "Mariä Himmelfahrt", date: fixedDate(time.August, 15)},
+		{name: "Nationalfeiertag", date: fixedDate(time.October, 26)},
+		{name: "Allerheiligen", date: fixedDate(time.November, 1)},
+		{name: "Mariä Empfängnis", date: fixedDate(time.December, 8)},
+		{name: "Christtag", date: fixedDate(time.December, 25)},
+		{name: "Stefanitag", date: fixedDate(time.December, 26)},
+	},
+	"US": {
+		{name: "New Year's Day", date: fixedDate(time.January, 1)},
+		{name: "Martin Luther King Jr. Day", date: nthWeekdayDate(time.January, time.Monday, 3)},
+		{name: "Washington's Birthday", date: nthWeekdayDate(time.February, time.Monday, 3)},
+		{name: "Memorial Day", date: nthWeekdayDate(time.May, time.Monday, -1)},
+		{name: "Juneteenth", date: fixedDate(time.June, 19)},
+		{name: "Independence Day", date: fixedDate(time.July, 4)},
+		{name: "Labor Day", date: nthWeekdayDate(time.September, time.Monday, 1)},
+		{name: "Columbus Day", date: nthWeekdayDate(time.October, time.Monday, 2)},
+		{name: "Veterans Day", date: fixedDate(time.November, 11)},
+		{name: "Thanksgiving Day", date: nthWeekdayDate(time.November, time.Thursday, 4)},
+		{name: "Christmas Day", date: fixedDate(time.December, 25)},
+	},
+}
+
+var holidaySubdivisions = map[string][]string{
+	"DE": {"BW", "BY", "BE", "BB", "HB", "HH", "HE", "MV", "NI", "NW", "RP", "SL", "SN", "ST", "SH", "TH"},
+}
+
+func NewHolidayCalendar(country string, subdivision string) (*HolidayCalendar, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	definitions, ok := holidayDefinitions[country]
+	if !ok {
+		return nil, fmt.Errorf("unsupported holiday country: %s", country)
+	}
+	subdivision = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(subdivision)), country+"-")
+	if subdivision != "" && !contains(holidaySubdivisions[country], subdivision) {
+		return nil, fmt.Errorf("unsupported holiday subdivision for %s: %s", country, subdivision)
+	}
+	var applicable []holidayDefinition
+	for _, definition := range definitions {
+		if len(definition.subdivisions) == 0 || contains(definition.subdivisions, subdivision) {
+			applicable = append(applicable, definition)
+		}
+	}
+	return &HolidayCalendar{Country: country, Subdivision: subdivision, definitions: applicable}, nil
+}
+
+func (c *HolidayCalendar) Holidays(year int) []Holiday {
+	var holidays []Holiday
+	for _, definition := range c.definitions {
+		holidays = append(holidays, Holiday{Name: definition.name, Date: definition.date(year)})
+	}
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return holidays
+}
+
+func (c *HolidayCalendar) HolidayOn(date time.Time) *Holiday {
+	day := civilDate(date)
+	for _, definition := range c.definitions {
+		if definition.date(day.Year()).Equal(day) {
+			return &Holiday{Name: definition.name, Date: day}
+		}
+	}
+	return nil
+}
+
+func (c *HolidayCalendar) Matches(date time.Time) bool {
+	return c.HolidayOn(date) != nil
+}
+
+// easterSunday uses the anonymous gregorian algorithm (Meeus/Jones/Butcher)
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func fixedDate(month time.Month, day int) func(year int) time.Time {
+	return func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func easterOffset(days int) func(year int) time.Time {
+	return func(year int) time.Time {
+		return easterSunday(year).AddDate(0, 0, days)
+	}
+}
+
+func nthWeekdayDate(month time.Month, weekday time.Weekday, n int) func(year int) time.Time {
+	return func(year int) time.Time {
+		if n < 0 {
+			last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+			return last.AddDate(0, 0, -((int(last.Weekday())-int(weekday)+7)%7)+7*(n+1))
+		}
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		return first.AddDate(0, 0, (int(weekday)-int(first.Weekday())+7)%7+7*(n-1))
+	}
+}
+
+// repentanceDay is the last wednesday before the 23rd of november
+func repentanceDay(year int) time.Time {
+	reference := time.Date(year, time.November, 22, 0, 0, 0, 0, time.UTC)
+	return reference.AddDate(0, 0, -((int(reference.Weekday()) - int(time.Wednesday) + 7) % 7))
+}
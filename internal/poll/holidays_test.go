@@ -0,0 +1,110 @@
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEasterSunday(t *testing.T) {
+	parameters := []struct {
+		year     int
+		expected time.Time
+	}{
+		{year: 2024, expected: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{year: 2025, expected: time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)},
+		{year: 2026, expected: time.Date(2026, 4, 5, 0, 0, 0, 0, time.UTC)},
+		{year: 2027, expected: time.Date(2027, 3, 28, 0, 0, 0, 0, time.UTC)},
+		{year: 2038, expected: time.Date(2038, 4, 25, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.expected.Format(time.DateOnly), func(t *testing.T) {
+			assert.Equal(t, parameter.expected, easterSunday(parameter.year))
+		})
+	}
+}
+
+func TestNewHolidayCalendar(t *testing.T) {
+	parameters := []struct {
+		name        string
+		country     string
+		subdivision string
+		expectError bool
+	}{
+		{name: "country", country: "DE"},
+		{name: "lowercase country", country: "at"},
+		{name: "subdivision", country: "DE", subdivision: "BY"},
+		{name: "iso subdivision", country: "DE", subdivision: "DE-SN"},
+		{name: "unknown country", country: "XX", expectError: true},
+		{name: "unknown subdivision", country: "DE", subdivision: "XX", expectError: true},
+		{name: "country without subdivisions", country: "US", subdivision: "CA", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			calendar, err := NewHolidayCalendar(parameter.country, parameter.subdivision)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, calendar)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendar_HolidayOn(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	parameters := []struct {
+		name         string
+		country      string
+		subdivision  string
+		date         time.Time
+		expectedName string
+	}{
+		{name: "fixed holiday", country: "DE", date: time.Date(2026, 10, 3, 20, 0, 0, 0, berlin), expectedName: "Tag der Deutschen Einheit"},
+		{name: "good friday", country: "DE", date: time.Date(2026, 4, 3, 20, 0, 0, 0, berlin), expectedName: "Karfreitag"},
+		{name: "whit monday", country: "DE", date: time.Date(2026, 5, 25, 20, 0, 0, 0, berlin), expectedName: "Pfingstmontag"},
+		{name: "corpus christi in bavaria", country: "DE", subdivision: "BY", date: time.Date(2026, 6, 4, 20, 0, 0, 0, berlin), expectedName: "Fronleichnam"},
+		{name: "corpus christi nationwide", country: "DE", date: time.Date(2026, 6, 4, 20, 0, 0, 0, berlin)},
+		{name: "reformation day in saxony", country: "DE", subdivision: "SN", date: time.Date(2026, 10, 31, 20, 0, 0, 0, berlin), expectedName: "Reformationstag"},
+		{name: "repentance day in saxony", country: "DE", subdivision: "SN", date: time.Date(2026, 11, 18, 20, 0, 0, 0, berlin), expectedName: "Buß- und Bettag"},
+		{name: "corpus christi in austria", country: "AT", date: time.Date(2027, 5, 27, 20, 0, 0, 0, berlin), expectedName: "Fronleichnam"},
+		{name: "thanksgiving", country: "US", date: time.Date(2026, 11, 26, 19, 0, 0, 0, time.UTC), expectedName: "Thanksgiving Day"},
+		{name: "memorial day", country: "US", date: time.Date(2026, 5, 25, 19, 0, 0, 0, time.UTC), expectedName: "Memorial Day"},
+		{name: "regular day", country: "DE", date: time.Date(2026, 10, 2, 20, 0, 0, 0, berlin)},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			calendar, err := NewHolidayCalendar(parameter.country, parameter.subdivision)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			holiday := calendar.HolidayOn(parameter.date)
+
+			if parameter.expectedName == "" {
+				assert.Nil(t, holiday)
+				assert.False(t, calendar.Matches(parameter.date))
+			} else if assert.NotNil(t, holiday) {
+				assert.Equal(t, parameter.expectedName, holiday.Name)
+				assert.True(t, calendar.Matches(parameter.date))
+			}
+		})
+	}
+}
+
+func TestHolidayCalendar_Holidays(t *testing.T) {
+	calendar, _ := NewHolidayCalendar("DE", "")
+
+	holidays := calendar.Holidays(2026)
+
+	assert.Len(t, holidays, 9)
+	assert.Equal(t, Holiday{Name: "Neujahr", Date: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}, holidays[0])
+	assert.Equal(t, Holiday{Name: "Christi Himmelfahrt", Date: time.Date(2026, 5, 14, 0, 0, 0, 0, time.UTC)}, holidays[4])
+	assert.Equal(t, Holiday{Name: "2. Weihnachtstag", Date: time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC)}, holidays[8])
+}
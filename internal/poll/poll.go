@@ -1,58 +1,388 @@
 package poll
 
 import (
+	"cmp"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
-const maxAnswers = 10
+const (
+	maxAnswers = 10
+	// discord only accepts polls that run between one hour and 32 days
+	minDurationHours = 1
+	maxDurationHours = 32 * 24
+)
+
+var DefaultStartTime = TimeOfDay{Hour: 20, Minute: 0}
 
 type DatePoll struct {
-	Question string
-	Answers  []time.Time
-	Expiry   time.Time
+	Question     string
+	Answers      []time.Time
+	Expiry       time.Time
+	GroupID      string
+	Part         int
+	Parts        int
+	Flagged      []time.Time
+	AnswerFormat string
+	Runoff       bool
+	Period       string
+	Profile      string
+}
+
+// PostedPoll is a part of a date poll that was already sent to a channel
+type PostedPoll struct {
+	ID        string
+	GroupID   string
+	Part      int
+	Pinned    bool
+	Announced bool
+}
+
+type DateMatcher interface {
+	Matches(date time.Time) bool
+}
+
+type DateFilter struct {
+	Include []DateMatcher
+	Exclude []DateMatcher
+	Flag    []DateMatcher
+}
+
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+type StartTimes struct {
+	Default  TimeOfDay
+	Weekdays map[time.Weekday]TimeOfDay
 }
 
 type DatePollResult struct {
-	PollID         string
+	PollIDs        []string
+	Question       string
+	Answers        []time.Time
+	Scores         []AnswerScore
 	WinningAnswers []time.Time
+	WinningScore   float64
+	TotalVotes     int
 	Finalized      bool
+	Runoff         bool
+	// zero if discord did not report when the poll closes
+	Expiry time.Time
 }
 
-func NewDatePoll(question string, year int, month time.Month, weekdays []time.Weekday, location *time.Location, additionalDays []int, excludedDays []int) *DatePoll {
+func NewDatePoll(question string, year int, month time.Month, weekdays []time.Weekday, startTimes StartTimes, location *time.Location, additionalDays []int, excludedDays []int, filter DateFilter) *DatePoll {
+	answers := getDates(year, month, weekdays, startTimes, location, additionalDays, excludedDays, filter)
 	return &DatePoll{
 		Question: question,
 		Expiry:   time.Date(year, month, 0, 12, 0, 0, 0, location),
-		Answers:  getDates(year, month, weekdays, location, additionalDays, excludedDays),
+		Answers:  answers,
+		Flagged:  getFlaggedDates(answers, filter),
+	}
+}
+
+func NewDateRangePoll(question string, firstDay time.Time, lastDay time.Time, weekdays []time.Weekday, startTimes StartTimes, location *time.Location, excludedDates []time.Time, filter DateFilter) *DatePoll {
+	answers := getRangeDates(firstDay, lastDay, weekdays, startTimes, location, excludedDates, filter)
+	return &DatePoll{
+		Question: question,
+		Expiry:   time.Date(firstDay.Year(), firstDay.Month(), firstDay.Day()-1, 12, 0, 0, 0, location),
+		Answers:  answers,
+		Flagged:  getFlaggedDates(answers, filter),
+	}
+}
+
+func NewRunoffPoll(question string, tied []time.Time, expiry time.Time, answerFormat string) *DatePoll {
+	return &DatePoll{
+		Question:     question,
+		Answers:      upcomingDates(tied, expiry),
+		Expiry:       expiry,
+		AnswerFormat: answerFormat,
+		Runoff:       true,
+	}
+}
+
+func NewFollowUpPoll(question string, answers []time.Time, expiry time.Time, answerFormat string) *DatePoll {
+	return &DatePoll{
+		Question:     question,
+		Answers:      upcomingDates(answers, expiry),
+		Expiry:       expiry,
+		AnswerFormat: answerFormat,
+	}
+}
+
+// upcomingDates sorts the dates and drops those before the poll closes, as they cannot be chosen anymore
+func upcomingDates(dates []time.Time, expiry time.Time) []time.Time {
+	var upcoming []time.Time
+	for _, date := range sortedDates(dates) {
+		if date.After(expiry) {
+			upcoming = append(upcoming, date)
+		}
 	}
+	return upcoming
 }
 
-func NewDatePollResult(pollID string, winningAnswers []time.Time, finalized bool) *DatePollResult {
+// CloseAt replaces the default expiry, dates before the poll closes cannot be chosen anymore
+func (p *DatePoll) CloseAt(expiry time.Time) {
+	p.Expiry = expiry
+	p.Answers = slices.DeleteFunc(p.Answers, func(answer time.Time) bool { return !answer.After(expiry) })
+	p.Flagged = slices.DeleteFunc(p.Flagged, func(flagged time.Time) bool { return !flagged.After(expiry) })
+}
+
+// ValidateExpiry reports polls that discord would reject, because they close too soon or too late
+func (p *DatePoll) ValidateExpiry(now time.Time) error {
+	hours := int(math.Floor(p.Expiry.Sub(now).Hours()))
+	if hours < minDurationHours {
+		return fmt.Errorf("poll would close at %s, which is less than %d hour from now", p.Expiry.Format(time.RFC3339), minDurationHours)
+	}
+	if hours > maxDurationHours {
+		return fmt.Errorf("poll would close at %s, which is more than %d days from now", p.Expiry.Format(time.RFC3339), maxDurationHours/24)
+	}
+	return nil
+}
+
+func (p *DatePoll) Split() []*DatePoll {
+	if len(p.Answers) <= maxAnswers {
+		return []*DatePoll{p}
+	}
+	parts := (len(p.Answers) + maxAnswers - 1) / maxAnswers
+	partSize := (len(p.Answers) + parts - 1) / parts
+	groupID := rand.Text()
+	var polls []*DatePoll
+	for part := 0; part < parts; part++ {
+		end := min((part+1)*partSize, len(p.Answers))
+		polls = append(polls, &DatePoll{
+			Question:     fmt.Sprintf("%s (%d/%d)", p.Question, part+1, parts),
+			Answers:      p.Answers[part*partSize : end],
+			Expiry:       p.Expiry,
+			GroupID:      groupID,
+			Part:         part + 1,
+			Parts:        parts,
+			Flagged:      p.Flagged,
+			AnswerFormat: p.AnswerFormat,
+			Runoff:       p.Runoff,
+			Period:       p.Period,
+			Profile:      p.Profile,
+		})
+	}
+	return polls
+}
+
+func (p *DatePoll) IsFlagged(answer time.Time) bool {
+	return slices.ContainsFunc(p.Flagged, answer.Equal)
+}
+
+func NewDateFilter(include []DateMatcher, exclude []DateMatcher, flag []DateMatcher) DateFilter {
+	return DateFilter{
+		Include: include,
+		Exclude: exclude,
+		Flag:    flag,
+	}
+}
+
+func (f DateFilter) Includes(date time.Time) bool {
+	return matchesAny(f.Include, date)
+}
+
+func (f DateFilter) Excludes(date time.Time) bool {
+	return matchesAny(f.Exclude, date)
+}
+
+func (f DateFilter) Flags(date time.Time) bool {
+	return matchesAny(f.Flag, date)
+}
+
+func NewStartTimes(defaultTime TimeOfDay, weekdayTimes map[time.Weekday]TimeOfDay) StartTimes {
+	return StartTimes{
+		Default:  defaultTime,
+		Weekdays: weekdayTimes,
+	}
+}
+
+func (s StartTimes) ForWeekday(weekday time.Weekday) TimeOfDay {
+	if startTime, ok := s.Weekdays[weekday]; ok {
+		return startTime
+	}
+	return s.Default
+}
+
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("time of day must be formatted as HH:MM: %s", value)
+	}
+	return TimeOfDay{Hour: parsed.Hour(), Minute: parsed.Minute()}, nil
+}
+
+func (t TimeOfDay) On(year int, month time.Month, day int, location *time.Location) time.Time {
+	return time.Date(year, month, day, t.Hour, t.Minute, 0, 0, location)
+}
+
+func NewDatePollResult(pollIDs []string, winningAnswers []time.Time, winningScore float64, totalVotes int, finalized bool) *DatePollResult {
 	return &DatePollResult{
-		PollID:         pollID,
+		PollIDs:        pollIDs,
 		WinningAnswers: winningAnswers,
+		WinningScore:   winningScore,
+		TotalVotes:     totalVotes,
 		Finalized:      finalized,
 	}
 }
 
-func getDates(year int, month time.Month, weekdays []time.Weekday, location *time.Location, additionalDays []int, excludedDays []int) []time.Time {
-	var dates []time.Time
-	count := 0
-	firstDay := time.Date(year, month, 1, 20, 0, 0, 0, location)
-	lastDay := time.Date(year, month+1, 0, 20, 0, 0, 0, location)
-	for day := firstDay; day.Before(lastDay.AddDate(0, 0, 1)); day = day.AddDate(0, 0, 1) {
-		if contains(excludedDays, day.Day()) {
-			continue
-		}
-		if contains(weekdays, day.Weekday()) || contains(additionalDays, day.Day()) {
-			if count < maxAnswers {
-				dates = append(dates, day)
-				count++
+// MeetsThreshold checks the minimum votes of the winning date and the quorum of distinct voters,
+// the minimum counts the votes as cast, so that weighted strategies do not change its meaning,
+// multiselect lets a single member vote for every date, so the quorum requires the voters of each answer
+func (r *DatePollResult) MeetsThreshold(minVotes int, quorum int) bool {
+	return len(r.WinningAnswers) > 0 && r.winningVotes() >= minVotes && len(r.Voters()) >= quorum
+}
+
+// winningVotes returns the most votes cast for one of the winning answers
+func (r *DatePollResult) winningVotes() int {
+	var votes int
+	for _, score := range r.Scores {
+		if slices.ContainsFunc(r.WinningAnswers, score.Date.Equal) {
+			votes = max(votes, score.Votes)
+		}
+	}
+	return votes
+}
+
+// Standings returns the scores ordered by score and votes, equal answers keep their date order
+func (r *DatePollResult) Standings() []AnswerScore {
+	standings := slices.Clone(r.Scores)
+	slices.SortStableFunc(standings, func(a, b AnswerScore) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+		return cmp.Compare(b.Votes, a.Votes)
+	})
+	return standings
+}
+
+// RunnerUp returns the best voted answer besides the winner, nil if no other answer received votes
+func (r *DatePollResult) RunnerUp(winner time.Time) *AnswerScore {
+	for _, standing := range r.Standings() {
+		if standing.Votes > 0 && !standing.Date.Equal(winner) {
+			return &standing
+		}
+	}
+	return nil
+}
+
+func (r *DatePollResult) VotersOf(date time.Time) []string {
+	for _, score := range r.Scores {
+		if score.Date.Equal(date) {
+			return score.Voters
+		}
+	}
+	return nil
+}
+
+// Voters returns everyone who voted for any answer
+func (r *DatePollResult) Voters() []string {
+	var voters []string
+	for _, score := range r.Scores {
+		for _, voter := range score.Voters {
+			if !slices.Contains(voters, voter) {
+				voters = append(voters, voter)
+			}
+		}
+	}
+	return voters
+}
+
+// OtherVoters returns everyone who voted, but not for the given date
+func (r *DatePollResult) OtherVoters(date time.Time) []string {
+	dateVoters := r.VotersOf(date)
+	return slices.DeleteFunc(r.Voters(), func(voter string) bool {
+		return slices.Contains(dateVoters, voter)
+	})
+}
+
+func getDates(year int, month time.Month, weekdays []time.Weekday, startTimes StartTimes, location *time.Location, additionalDays []int, excludedDays []int, filter DateFilter) []time.Time {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, location)
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, location)
+	return collectDates(firstDay, lastDay, startTimes, location, func(date time.Time) bool {
+		if contains(excludedDays, date.Day()) || filter.Excludes(date) {
+			return false
+		}
+		return contains(weekdays, date.Weekday()) || contains(additionalDays, date.Day()) || filter.Includes(date)
+	})
+}
+
+func getRangeDates(firstDay time.Time, lastDay time.Time, weekdays []time.Weekday, startTimes StartTimes, location *time.Location, excludedDates []time.Time, filter DateFilter) []time.Time {
+	return collectDates(firstDay, lastDay, startTimes, location, func(date time.Time) bool {
+		for _, excludedDate := range excludedDates {
+			if isSameDay(date, excludedDate) {
+				return false
 			}
 		}
+		if filter.Excludes(date) {
+			return false
+		}
+		return contains(weekdays, date.Weekday()) || filter.Includes(date)
+	})
+}
+
+func collectDates(firstDay time.Time, lastDay time.Time, startTimes StartTimes, location *time.Location, isCandidate func(date time.Time) bool) []time.Time {
+	var dates []time.Time
+	// iterate over calendar days instead of adding durations, so that daylight saving time changes are irrelevant
+	for offset := 0; ; offset++ {
+		date := time.Date(firstDay.Year(), firstDay.Month(), firstDay.Day()+offset, 0, 0, 0, 0, location)
+		if date.After(lastDay) && !isSameDay(date, lastDay) {
+			break
+		}
+		if isCandidate(date) {
+			dates = append(dates, startTimes.ForWeekday(date.Weekday()).On(date.Year(), date.Month(), date.Day(), location))
+		}
 	}
 	return dates
 }
 
+func getFlaggedDates(answers []time.Time, filter DateFilter) []time.Time {
+	var flagged []time.Time
+	for _, answer := range answers {
+		if filter.Flags(answer) {
+			flagged = append(flagged, answer)
+		}
+	}
+	return flagged
+}
+
+func isSameDay(a time.Time, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
+
+func ParseWeekday(value string) (time.Weekday, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if number, err := strconv.Atoi(normalized); err == nil {
+		if number < 1 || number > 7 {
+			return 0, fmt.Errorf("weekday number must be between 1 (monday) and 7 (sunday): %d", number)
+		}
+		return time.Weekday(number % 7), nil
+	}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := strings.ToLower(weekday.String())
+		if normalized == name || (len(normalized) >= 2 && strings.HasPrefix(name, normalized)) {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday: %s", value)
+}
+
+func matchesAny(matchers []DateMatcher, date time.Time) bool {
+	for _, matcher := range matchers {
+		if matcher.Matches(date) {
+			return true
+		}
+	}
+	return false
+}
+
 func contains[T comparable](s []T, e T) bool {
 	for _, a := range s {
 		if a == e {
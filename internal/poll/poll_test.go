@@ -1,6 +1,7 @@
 package poll
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ func TestNewDatePoll(t *testing.T) {
 		year            int
 		month           time.Month
 		weekdays        []time.Weekday
+		startTimes      StartTimes
 		additionalDays  []int
 		excludedDays    []int
 		expectedAnswers []time.Time
@@ -23,6 +25,7 @@ func TestNewDatePoll(t *testing.T) {
 			year:           2025,
 			month:          time.May,
 			weekdays:       []time.Weekday{time.Friday, time.Saturday},
+			startTimes:     NewStartTimes(DefaultStartTime, nil),
 			additionalDays: []int{},
 			excludedDays:   []int{},
 			expectedAnswers: []time.Time{
@@ -44,6 +47,7 @@ func TestNewDatePoll(t *testing.T) {
 			year:           2025,
 			month:          time.December,
 			weekdays:       []time.Weekday{time.Friday},
+			startTimes:     NewStartTimes(DefaultStartTime, nil),
 			additionalDays: []int{},
 			excludedDays:   []int{},
 			expectedAnswers: []time.Time{
@@ -59,6 +63,7 @@ func TestNewDatePoll(t *testing.T) {
 			year:           2026,
 			month:          time.January,
 			weekdays:       []time.Weekday{time.Saturday},
+			startTimes:     NewStartTimes(DefaultStartTime, nil),
 			additionalDays: []int{},
 			excludedDays:   []int{},
 			expectedAnswers: []time.Time{
@@ -75,6 +80,7 @@ func TestNewDatePoll(t *testing.T) {
 			year:           2028,
 			month:          time.March,
 			weekdays:       []time.Weekday{time.Wednesday},
+			startTimes:     NewStartTimes(DefaultStartTime, nil),
 			additionalDays: []int{},
 			excludedDays:   []int{},
 			expectedAnswers: []time.Time{
@@ -91,6 +97,7 @@ func TestNewDatePoll(t *testing.T) {
 			year:           2025,
 			month:          time.December,
 			weekdays:       []time.Weekday{time.Friday, time.Saturday},
+			startTimes:     NewStartTimes(DefaultStartTime, nil),
 			additionalDays: []int{26, 27, 28, 29, 30},
 			excludedDays:   []int{23, 24, 25, 31},
 			expectedAnswers: []time.Time{
@@ -104,14 +111,129 @@ func TestNewDatePoll(t *testing.T) {
 				time.Date(2025, 12, 27, 20, 0, 0, 0, time.UTC),
 				time.Date(2025, 12, 28, 20, 0, 0, 0, time.UTC),
 				time.Date(2025, 12, 29, 20, 0, 0, 0, time.UTC),
+				time.Date(2025, 12, 30, 20, 0, 0, 0, time.UTC),
 			},
 			expectedExpiry: time.Date(2025, 11, 30, 12, 0, 0, 0, time.UTC),
 		},
+		{
+			name:           "poll with custom and per-weekday start times",
+			year:           2026,
+			month:          time.October,
+			weekdays:       []time.Weekday{time.Friday, time.Saturday},
+			startTimes:     NewStartTimes(TimeOfDay{Hour: 19, Minute: 30}, map[time.Weekday]TimeOfDay{time.Saturday: {Hour: 14}}),
+			additionalDays: []int{},
+			excludedDays:   []int{9, 10, 16, 17, 23, 24},
+			expectedAnswers: []time.Time{
+				time.Date(2026, 10, 2, 19, 30, 0, 0, time.UTC),
+				time.Date(2026, 10, 3, 14, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 30, 19, 30, 0, 0, time.UTC),
+				time.Date(2026, 10, 31, 14, 0, 0, 0, time.UTC),
+			},
+			expectedExpiry: time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			poll := NewDatePoll("TestQuestion", parameter.year, parameter.month, parameter.weekdays, parameter.startTimes, time.UTC, parameter.additionalDays, parameter.excludedDays, DateFilter{})
+
+			assert.NotNil(t, poll)
+			assert.Equal(t, "TestQuestion", poll.Question)
+			assert.Equal(t, parameter.expectedAnswers, poll.Answers)
+			assert.Equal(t, parameter.expectedExpiry, poll.Expiry)
+		})
+	}
+}
+
+func TestNewDatePoll_WithDateFilter(t *testing.T) {
+	lastWeekend, _ := ParseRule("FREQ=MONTHLY;BYDAY=SA,SU;BYSETPOS=-2,-1")
+	christmas, _ := ParseRule("FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24,25,26")
+	filter := NewDateFilter([]DateMatcher{lastWeekend}, []DateMatcher{christmas}, nil)
+
+	poll := NewDatePoll("TestQuestion", 2026, time.December, []time.Weekday{time.Saturday}, NewStartTimes(DefaultStartTime, nil), time.UTC, []int{}, []int{}, filter)
+
+	assert.Equal(t, []time.Time{
+		time.Date(2026, 12, 5, 20, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 12, 20, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 19, 20, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 27, 20, 0, 0, 0, time.UTC),
+	}, poll.Answers)
+}
+
+func TestNewDatePoll_WithFlaggedHolidays(t *testing.T) {
+	holidays, _ := NewHolidayCalendar("DE", "")
+	filter := NewDateFilter(nil, nil, []DateMatcher{holidays})
+
+	poll := NewDatePoll("TestQuestion", 2026, time.October, []time.Weekday{time.Saturday}, NewStartTimes(DefaultStartTime, nil), time.UTC, []int{}, []int{}, filter)
+
+	assert.Len(t, poll.Answers, 5)
+	assert.Equal(t, []time.Time{time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)}, poll.Flagged)
+	assert.True(t, poll.IsFlagged(time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)))
+	assert.False(t, poll.IsFlagged(time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC)))
+	for _, part := range poll.Split() {
+		assert.Equal(t, poll.Flagged, part.Flagged)
+	}
+}
+
+func TestNewDateRangePoll(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	parameters := []struct {
+		name            string
+		firstDay        time.Time
+		lastDay         time.Time
+		weekdays        []time.Weekday
+		excludedDates   []time.Time
+		location        *time.Location
+		expectedAnswers []time.Time
+		expectedExpiry  time.Time
+	}{
+		{
+			name:     "two weeks across a month boundary",
+			firstDay: time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2026, 11, 6, 0, 0, 0, 0, time.UTC),
+			weekdays: []time.Weekday{time.Friday, time.Saturday},
+			location: time.UTC,
+			expectedAnswers: []time.Time{
+				time.Date(2026, 10, 24, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 30, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 31, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 6, 20, 0, 0, 0, time.UTC),
+			},
+			expectedExpiry: time.Date(2026, 10, 23, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "six weeks with excluded dates",
+			firstDay:      time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:       time.Date(2027, 1, 11, 0, 0, 0, 0, time.UTC),
+			weekdays:      []time.Weekday{time.Saturday},
+			excludedDates: []time.Time{time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 2, 0, 0, 0, 0, time.UTC)},
+			location:      time.UTC,
+			expectedAnswers: []time.Time{
+				time.Date(2026, 12, 5, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 12, 20, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 19, 20, 0, 0, 0, time.UTC),
+				time.Date(2027, 1, 9, 20, 0, 0, 0, time.UTC),
+			},
+			expectedExpiry: time.Date(2026, 11, 30, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "range across daylight saving time change",
+			firstDay: time.Date(2026, 10, 24, 0, 0, 0, 0, berlin),
+			lastDay:  time.Date(2026, 10, 26, 0, 0, 0, 0, berlin),
+			weekdays: []time.Weekday{time.Saturday, time.Sunday, time.Monday},
+			location: berlin,
+			expectedAnswers: []time.Time{
+				time.Date(2026, 10, 24, 20, 0, 0, 0, berlin),
+				time.Date(2026, 10, 25, 20, 0, 0, 0, berlin),
+				time.Date(2026, 10, 26, 20, 0, 0, 0, berlin),
+			},
+			expectedExpiry: time.Date(2026, 10, 23, 12, 0, 0, 0, berlin),
+		},
 	}
 
 	for _, parameter := range parameters {
 		t.Run(parameter.name, func(t *testing.T) {
-			poll := NewDatePoll("TestQuestion", parameter.year, parameter.month, parameter.weekdays, time.UTC, parameter.additionalDays, parameter.excludedDays)
+			poll := NewDateRangePoll("TestQuestion", parameter.firstDay, parameter.lastDay, parameter.weekdays, NewStartTimes(DefaultStartTime, nil), parameter.location, parameter.excludedDates, DateFilter{})
 
 			assert.NotNil(t, poll)
 			assert.Equal(t, "TestQuestion", poll.Question)
@@ -121,38 +243,335 @@ func TestNewDatePoll(t *testing.T) {
 	}
 }
 
+func TestDatePoll_Split(t *testing.T) {
+	makeDates := func(count int) []time.Time {
+		var dates []time.Time
+		for i := 0; i < count; i++ {
+			dates = append(dates, time.Date(2026, 10, 1+i, 20, 0, 0, 0, time.UTC))
+		}
+		return dates
+	}
+	parameters := []struct {
+		name              string
+		answers           int
+		expectedPartSizes []int
+	}{
+		{name: "no split needed", answers: 10, expectedPartSizes: []int{10}},
+		{name: "split into two balanced parts", answers: 11, expectedPartSizes: []int{6, 5}},
+		{name: "split into three parts", answers: 25, expectedPartSizes: []int{9, 9, 7}},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			datePoll := &DatePoll{Question: "TestQuestion", Answers: makeDates(parameter.answers), Expiry: time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC), Period: "2026-10", Profile: "game-night"}
+
+			parts := datePoll.Split()
+
+			if !assert.Len(t, parts, len(parameter.expectedPartSizes)) {
+				return
+			}
+			if len(parts) == 1 {
+				assert.Same(t, datePoll, parts[0])
+				return
+			}
+			var answers []time.Time
+			for i, part := range parts {
+				assert.Len(t, part.Answers, parameter.expectedPartSizes[i])
+				assert.Equal(t, fmt.Sprintf("TestQuestion (%d/%d)", i+1, len(parts)), part.Question)
+				assert.Equal(t, parts[0].GroupID, part.GroupID)
+				assert.NotEmpty(t, part.GroupID)
+				assert.Equal(t, i+1, part.Part)
+				assert.Equal(t, len(parts), part.Parts)
+				assert.Equal(t, datePoll.Expiry, part.Expiry)
+				assert.Equal(t, "2026-10", part.Period)
+				assert.Equal(t, "game-night", part.Profile)
+				answers = append(answers, part.Answers...)
+			}
+			assert.Equal(t, datePoll.Answers, answers)
+		})
+	}
+}
+
+func TestDatePoll_CloseAt(t *testing.T) {
+	today := time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC)
+	tomorrow := time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC)
+	nextWeek := time.Date(2026, 10, 24, 20, 0, 0, 0, time.UTC)
+	expiry := time.Date(2026, 10, 18, 9, 1, 0, 0, time.UTC)
+	datePoll := &DatePoll{Answers: []time.Time{today, tomorrow, nextWeek}, Flagged: []time.Time{today, nextWeek}}
+
+	datePoll.CloseAt(expiry)
+
+	assert.Equal(t, expiry, datePoll.Expiry)
+	assert.Equal(t, []time.Time{tomorrow, nextWeek}, datePoll.Answers)
+	assert.Equal(t, []time.Time{nextWeek}, datePoll.Flagged)
+}
+
+func TestDatePoll_ValidateExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	parameters := []struct {
+		name        string
+		expiry      time.Time
+		expectError bool
+	}{
+		{name: "already expired", expiry: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC), expectError: true},
+		{name: "less than an hour", expiry: now.Add(59 * time.Minute), expectError: true},
+		{name: "one hour", expiry: now.Add(time.Hour)},
+		{name: "32 days", expiry: now.Add(32*24*time.Hour + 59*time.Minute)},
+		{name: "more than 32 days", expiry: now.Add(33 * 24 * time.Hour), expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			datePoll := &DatePoll{Expiry: parameter.expiry}
+
+			err := datePoll.ValidateExpiry(now)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewRunoffPoll(t *testing.T) {
+	first := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC)
+	expiry := time.Date(2026, 10, 2, 12, 0, 0, 0, time.UTC)
+
+	past := time.Date(2026, 10, 2, 10, 0, 0, 0, time.UTC)
+
+	poll := NewRunoffPoll("Runoff", []time.Time{second, past, first}, expiry, "%Y-%m-%d")
+
+	assert.Equal(t, "Runoff", poll.Question)
+	assert.Equal(t, []time.Time{first, second}, poll.Answers)
+	assert.Equal(t, expiry, poll.Expiry)
+	assert.Equal(t, "%Y-%m-%d", poll.AnswerFormat)
+	assert.True(t, poll.Runoff)
+	for _, part := range poll.Split() {
+		assert.True(t, part.Runoff)
+	}
+}
+
 func TestNewDatePollResult(t *testing.T) {
 	parameters := []struct {
 		name           string
-		pollID         string
+		pollIDs        []string
 		winningAnswers []time.Time
+		winningScore   float64
+		totalVotes     int
 		finalized      bool
 	}{
 		{
 			name:           "no winning answers, not finalized",
-			pollID:         "poll-123",
+			pollIDs:        []string{"poll-123"},
 			winningAnswers: []time.Time{},
+			winningScore:   0,
+			totalVotes:     0,
 			finalized:      false,
 		},
 		{
-			name:   "multiple winning answers, finalized",
-			pollID: "poll-xyz",
+			name:    "multiple winning answers across poll parts, finalized",
+			pollIDs: []string{"poll-xyz", "poll-abc"},
 			winningAnswers: []time.Time{
 				time.Date(2025, 12, 5, 20, 0, 0, 0, time.UTC),
 				time.Date(2025, 12, 12, 20, 0, 0, 0, time.UTC),
 			},
-			finalized: true,
+			winningScore: 4,
+			totalVotes:   9,
+			finalized:    true,
 		},
 	}
 
 	for _, parameter := range parameters {
 		t.Run(parameter.name, func(t *testing.T) {
-			result := NewDatePollResult(parameter.pollID, parameter.winningAnswers, parameter.finalized)
+			result := NewDatePollResult(parameter.pollIDs, parameter.winningAnswers, parameter.winningScore, parameter.totalVotes, parameter.finalized)
 
 			assert.NotNil(t, result)
-			assert.Equal(t, parameter.pollID, result.PollID)
+			assert.Equal(t, parameter.pollIDs, result.PollIDs)
 			assert.Equal(t, parameter.winningAnswers, result.WinningAnswers)
+			assert.Equal(t, parameter.winningScore, result.WinningScore)
+			assert.Equal(t, parameter.totalVotes, result.TotalVotes)
 			assert.Equal(t, parameter.finalized, result.Finalized)
 		})
 	}
 }
+
+func TestDatePollResult_MeetsThreshold(t *testing.T) {
+	winner := []time.Time{time.Date(2025, 12, 5, 20, 0, 0, 0, time.UTC)}
+	// the first voters vote for the winner, the others for later dates
+	withVoters := func(result *DatePollResult, voters ...[]string) *DatePollResult {
+		for i, answerVoters := range voters {
+			result.Scores = append(result.Scores, AnswerScore{Date: winner[0].AddDate(0, 0, i), Votes: len(answerVoters), Voters: answerVoters})
+		}
+		return result
+	}
+	parameters := []struct {
+		name     string
+		result   *DatePollResult
+		minVotes int
+		quorum   int
+		expected bool
+	}{
+		{name: "no threshold", result: withVoters(NewDatePollResult(nil, winner, 1, 1, true), []string{"a"}), expected: true},
+		{name: "enough votes", result: withVoters(NewDatePollResult(nil, winner, 3, 4, true), []string{"a", "b", "c"}, []string{"d"}), minVotes: 3, quorum: 4, expected: true},
+		{name: "too few votes for winner", result: withVoters(NewDatePollResult(nil, winner, 2, 7, true), []string{"a", "b"}, []string{"c", "d", "e", "f", "g"}), minVotes: 3, expected: false},
+		{name: "enough votes with a weighted score below the votes", result: withVoters(NewDatePollResult(nil, winner, 0.5, 1, true), []string{"a"}), minVotes: 1, expected: true},
+		{name: "too few votes with a weighted score above the votes", result: withVoters(NewDatePollResult(nil, winner, 4, 2, true), []string{"a", "b"}), minVotes: 3, expected: false},
+		{name: "quorum not reached", result: withVoters(NewDatePollResult(nil, winner, 3, 6, true), []string{"a", "b", "c"}, []string{"a", "b", "c"}), quorum: 4, expected: false},
+		{name: "quorum not reached by a single voter of many dates", result: withVoters(NewDatePollResult(nil, winner, 1, 5, true), []string{"a"}, []string{"a"}, []string{"a"}, []string{"a"}, []string{"a"}), quorum: 5, expected: false},
+		{name: "no winner", result: NewDatePollResult(nil, nil, 0, 0, true), expected: false},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			assert.Equal(t, parameter.expected, parameter.result.MeetsThreshold(parameter.minVotes, parameter.quorum))
+		})
+	}
+}
+
+func TestDatePollResult_Standings(t *testing.T) {
+	friday := time.Date(2025, 12, 5, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, 12, 6, 20, 0, 0, 0, time.UTC)
+	sunday := time.Date(2025, 12, 7, 20, 0, 0, 0, time.UTC)
+	result := NewDatePollResult(nil, []time.Time{saturday}, 3, 6, true)
+	result.Scores = []AnswerScore{
+		{Date: friday, Votes: 2, Score: 2, Eligible: true},
+		{Date: saturday, Votes: 3, Score: 3, Eligible: true},
+		{Date: sunday, Votes: 1, Score: 2, Eligible: true},
+	}
+
+	assert.Equal(t, []AnswerScore{result.Scores[1], result.Scores[0], result.Scores[2]}, result.Standings())
+	assert.Equal(t, []time.Time{friday, saturday, sunday}, []time.Time{result.Scores[0].Date, result.Scores[1].Date, result.Scores[2].Date})
+}
+
+func TestDatePollResult_RunnerUp(t *testing.T) {
+	friday := time.Date(2025, 12, 5, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, 12, 6, 20, 0, 0, 0, time.UTC)
+	parameters := []struct {
+		name     string
+		scores   []AnswerScore
+		expected *AnswerScore
+	}{
+		{
+			name:     "second best answer",
+			scores:   []AnswerScore{{Date: friday, Votes: 2, Score: 2}, {Date: saturday, Votes: 3, Score: 3}},
+			expected: &AnswerScore{Date: friday, Votes: 2, Score: 2},
+		},
+		{
+			name:     "tied answer",
+			scores:   []AnswerScore{{Date: friday, Votes: 3, Score: 3}, {Date: saturday, Votes: 3, Score: 3}},
+			expected: &AnswerScore{Date: friday, Votes: 3, Score: 3},
+		},
+		{
+			name:   "no other votes",
+			scores: []AnswerScore{{Date: friday, Votes: 0, Score: 0}, {Date: saturday, Votes: 3, Score: 3}},
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			result := &DatePollResult{Scores: parameter.scores}
+
+			assert.Equal(t, parameter.expected, result.RunnerUp(saturday))
+		})
+	}
+}
+
+func TestDatePollResult_Voters(t *testing.T) {
+	friday := time.Date(2025, 12, 5, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, 12, 6, 20, 0, 0, 0, time.UTC)
+	sunday := time.Date(2025, 12, 7, 20, 0, 0, 0, time.UTC)
+	result := &DatePollResult{Scores: []AnswerScore{
+		{Date: friday, Votes: 2, Voters: []string{"alice", "bob"}},
+		{Date: saturday, Votes: 3, Voters: []string{"bob", "carol", "dave"}},
+		{Date: sunday, Votes: 2, Voters: []string{"alice", "erin"}},
+	}}
+
+	assert.Equal(t, []string{"bob", "carol", "dave"}, result.VotersOf(saturday))
+	assert.Equal(t, []string{"alice", "bob", "carol", "dave", "erin"}, result.Voters())
+	assert.Equal(t, []string{"alice", "erin"}, result.OtherVoters(saturday))
+	assert.Nil(t, result.VotersOf(time.Date(2025, 12, 8, 20, 0, 0, 0, time.UTC)))
+}
+
+func TestNewFollowUpPoll(t *testing.T) {
+	expiry := time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC)
+	past := time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)
+	first := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC)
+
+	poll := NewFollowUpPoll("Question", []time.Time{second, past, first}, expiry, "")
+
+	assert.Equal(t, "Question", poll.Question)
+	assert.Equal(t, []time.Time{first, second}, poll.Answers)
+	assert.Equal(t, expiry, poll.Expiry)
+	assert.False(t, poll.Runoff)
+}
+
+func TestParseWeekday(t *testing.T) {
+	parameters := []struct {
+		name            string
+		value           string
+		expectedWeekday time.Weekday
+		expectError     bool
+	}{
+		{name: "full name", value: "tuesday", expectedWeekday: time.Tuesday},
+		{name: "mixed case name with spaces", value: " Saturday ", expectedWeekday: time.Saturday},
+		{name: "abbreviated name", value: "thu", expectedWeekday: time.Thursday},
+		{name: "iso number for monday", value: "1", expectedWeekday: time.Monday},
+		{name: "iso number for sunday", value: "7", expectedWeekday: time.Sunday},
+		{name: "ambiguous abbreviation", value: "t", expectError: true},
+		{name: "iso number out of range", value: "0", expectError: true},
+		{name: "unknown name", value: "caturday", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			weekday, err := ParseWeekday(parameter.value)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedWeekday, weekday)
+			}
+		})
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	parameters := []struct {
+		name              string
+		value             string
+		expectedTimeOfDay TimeOfDay
+		expectError       bool
+	}{
+		{name: "evening time", value: "19:30", expectedTimeOfDay: TimeOfDay{Hour: 19, Minute: 30}},
+		{name: "afternoon time with spaces", value: " 14:00 ", expectedTimeOfDay: TimeOfDay{Hour: 14, Minute: 0}},
+		{name: "midnight", value: "00:00", expectedTimeOfDay: TimeOfDay{Hour: 0, Minute: 0}},
+		{name: "hour out of range", value: "24:00", expectError: true},
+		{name: "missing minutes", value: "19", expectError: true},
+		{name: "twelve hour clock", value: "7:30pm", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			timeOfDay, err := ParseTimeOfDay(parameter.value)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedTimeOfDay, timeOfDay)
+			}
+		})
+	}
+}
+
+func TestStartTimes_ForWeekday(t *testing.T) {
+	startTimes := NewStartTimes(TimeOfDay{Hour: 20}, map[time.Weekday]TimeOfDay{time.Saturday: {Hour: 14}})
+
+	assert.Equal(t, TimeOfDay{Hour: 20}, startTimes.ForWeekday(time.Friday))
+	assert.Equal(t, TimeOfDay{Hour: 14}, startTimes.ForWeekday(time.Saturday))
+}
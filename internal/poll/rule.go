@@ -0,0 +1,319 @@
+package poll
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Weekly Frequency = iota
+	Monthly
+	Yearly
+)
+
+var ruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// the default anchor for intervals is a monday, so that weekly intervals start at the beginning of a week
+var defaultRuleStart = time.Date(1970, 1, 5, 0, 0, 0, 0, time.UTC)
+
+type Rule struct {
+	Frequency  Frequency
+	Interval   int
+	Start      time.Time
+	Until      time.Time
+	ByDay      []RuleWeekday
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+}
+
+type RuleWeekday struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+func ParseRule(value string) (*Rule, error) {
+	rule := &Rule{Interval: 1, Start: defaultRuleStart}
+	frequencySet := false
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(value), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		key, partValue, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("rule part must be formatted as KEY=VALUE: %s", part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Frequency, err = parseFrequency(partValue)
+			frequencySet = true
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(partValue)
+			if err == nil && rule.Interval < 1 {
+				err = fmt.Errorf("interval must be positive: %d", rule.Interval)
+			}
+		case "DTSTART":
+			rule.Start, err = parseRuleDate(partValue)
+		case "UNTIL":
+			rule.Until, err = parseRuleDate(partValue)
+		case "WKST":
+			// weeks always start on monday, other week starts only matter for weekly rules with intervals
+			if strings.ToUpper(partValue) != "MO" {
+				err = fmt.Errorf("unsupported week start: %s", partValue)
+			}
+		case "BYDAY":
+			rule.ByDay, err = parseRuleWeekdays(partValue)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRuleNumbers(partValue, 1, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseRuleNumbers(partValue, 1, 12)
+			for _, month := range months {
+				if month < 0 {
+					err = fmt.Errorf("month must be positive: %d", month)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(month))
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRuleNumbers(partValue, 1, 366)
+		default:
+			err = fmt.Errorf("unsupported rule part: %s", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse rule '%s': %w", value, err)
+		}
+	}
+	if !frequencySet {
+		return nil, fmt.Errorf("could not parse rule '%s': FREQ is required", value)
+	}
+	if rule.Frequency == Weekly {
+		if len(rule.ByDay) == 0 {
+			return nil, fmt.Errorf("could not parse rule '%s': weekly rules require BYDAY", value)
+		}
+		for _, weekday := range rule.ByDay {
+			if weekday.Ordinal != 0 {
+				return nil, fmt.Errorf("could not parse rule '%s': weekly rules do not support BYDAY ordinals", value)
+			}
+		}
+	}
+	if rule.Frequency == Monthly && len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 {
+		return nil, fmt.Errorf("could not parse rule '%s': monthly rules require BYDAY or BYMONTHDAY", value)
+	}
+	if rule.Frequency == Yearly && len(rule.ByDay) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByMonth) == 0 {
+		return nil, fmt.Errorf("could not parse rule '%s': yearly rules require BYDAY, BYMONTHDAY or BYMONTH", value)
+	}
+	return rule, nil
+}
+
+func (r *Rule) Matches(date time.Time) bool {
+	day := civilDate(date)
+	if day.Before(civilDate(r.Start)) || (!r.Until.IsZero() && day.After(civilDate(r.Until))) {
+		return false
+	}
+	if !r.inInterval(day) {
+		return false
+	}
+	return slices.ContainsFunc(r.periodDates(day), func(candidate time.Time) bool {
+		return candidate.Equal(day)
+	})
+}
+
+func (r *Rule) inInterval(day time.Time) bool {
+	if r.Interval == 1 {
+		return true
+	}
+	start := civilDate(r.Start)
+	var periods int
+	switch r.Frequency {
+	case Weekly:
+		periods = int(startOfWeek(day).Sub(startOfWeek(start)).Hours() / 24 / 7)
+	case Monthly:
+		periods = (day.Year()-start.Year())*12 + int(day.Month()-start.Month())
+	case Yearly:
+		periods = day.Year() - start.Year()
+	}
+	return ((periods%r.Interval)+r.Interval)%r.Interval == 0
+}
+
+func (r *Rule) periodDates(day time.Time) []time.Time {
+	var dates []time.Time
+	switch r.Frequency {
+	case Weekly:
+		first := startOfWeek(day)
+		dates = r.filter(daysBetween(first, first.AddDate(0, 0, 6)), nil)
+	case Monthly:
+		first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+		monthDates := daysBetween(first, first.AddDate(0, 1, -1))
+		dates = r.filter(monthDates, monthDates)
+	case Yearly:
+		for month := time.January; month <= time.December; month++ {
+			first := time.Date(day.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+			monthDates := daysBetween(first, first.AddDate(0, 1, -1))
+			if len(r.ByMonth) > 0 {
+				// with BYMONTH, weekday ordinals refer to the month instead of the year
+				dates = append(dates, r.filter(monthDates, monthDates)...)
+			} else {
+				dates = append(dates, monthDates...)
+			}
+		}
+		if len(r.ByMonth) == 0 {
+			dates = r.filter(dates, dates)
+		}
+	}
+	return r.selectPositions(dates)
+}
+
+func (r *Rule) filter(dates []time.Time, ordinalScope []time.Time) []time.Time {
+	var filtered []time.Time
+	for _, date := range dates {
+		if len(r.ByMonth) > 0 && !contains(r.ByMonth, date.Month()) {
+			continue
+		}
+		if len(r.ByMonthDay) > 0 && !matchesMonthDay(r.ByMonthDay, date) {
+			continue
+		}
+		if len(r.ByDay) > 0 && !slices.ContainsFunc(r.ByDay, func(weekday RuleWeekday) bool {
+			return weekday.matches(date, ordinalScope)
+		}) {
+			continue
+		}
+		filtered = append(filtered, date)
+	}
+	return filtered
+}
+
+func (r *Rule) selectPositions(dates []time.Time) []time.Time {
+	if len(r.BySetPos) == 0 {
+		return dates
+	}
+	var selected []time.Time
+	for _, position := range r.BySetPos {
+		index := position - 1
+		if position < 0 {
+			index = len(dates) + position
+		}
+		if index >= 0 && index < len(dates) {
+			selected = append(selected, dates[index])
+		}
+	}
+	return selected
+}
+
+func (w RuleWeekday) matches(date time.Time, scope []time.Time) bool {
+	if date.Weekday() != w.Weekday {
+		return false
+	}
+	if w.Ordinal == 0 {
+		return true
+	}
+	var occurrences []time.Time
+	for _, candidate := range scope {
+		if candidate.Weekday() == w.Weekday {
+			occurrences = append(occurrences, candidate)
+		}
+	}
+	index := w.Ordinal - 1
+	if w.Ordinal < 0 {
+		index = len(occurrences) + w.Ordinal
+	}
+	return index >= 0 && index < len(occurrences) && occurrences[index].Equal(date)
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	switch strings.ToUpper(value) {
+	case "WEEKLY":
+		return Weekly, nil
+	case "MONTHLY":
+		return Monthly, nil
+	case "YEARLY":
+		return Yearly, nil
+	default:
+		return 0, fmt.Errorf("unsupported frequency: %s", value)
+	}
+}
+
+func parseRuleDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("date must be formatted as YYYYMMDD: %s", value)
+	}
+	return time.Parse("20060102", value[:8])
+}
+
+func parseRuleWeekdays(value string) ([]RuleWeekday, error) {
+	var weekdays []RuleWeekday
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToUpper(strings.TrimSpace(part))
+		if len(part) < 2 {
+			return nil, fmt.Errorf("invalid weekday: %s", part)
+		}
+		weekday, ok := ruleWeekdays[part[len(part)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday: %s", part)
+		}
+		ordinal := 0
+		if prefix := part[:len(part)-2]; prefix != "" {
+			var err error
+			ordinal, err = strconv.Atoi(prefix)
+			if err != nil || ordinal == 0 || ordinal < -53 || ordinal > 53 {
+				return nil, fmt.Errorf("invalid weekday ordinal: %s", part)
+			}
+		}
+		weekdays = append(weekdays, RuleWeekday{Ordinal: ordinal, Weekday: weekday})
+	}
+	return weekdays, nil
+}
+
+func parseRuleNumbers(value string, minimum int, maximum int) ([]int, error) {
+	var numbers []int
+	for _, part := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid number: %s", part)
+		}
+		if number == 0 || number < -maximum || number > maximum || (number > 0 && number < minimum) {
+			return nil, fmt.Errorf("number out of range: %d", number)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+func matchesMonthDay(monthDays []int, date time.Time) bool {
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, monthDay := range monthDays {
+		if monthDay == date.Day() || (monthDay < 0 && daysInMonth+monthDay+1 == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func civilDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func daysBetween(first time.Time, last time.Time) []time.Time {
+	var days []time.Time
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
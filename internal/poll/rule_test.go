@@ -0,0 +1,201 @@
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRule(t *testing.T) {
+	parameters := []struct {
+		name         string
+		value        string
+		expectedRule *Rule
+		expectError  bool
+	}{
+		{
+			name:  "last saturday of the month",
+			value: "FREQ=MONTHLY;BYDAY=-1SA",
+			expectedRule: &Rule{
+				Frequency: Monthly,
+				Interval:  1,
+				Start:     defaultRuleStart,
+				ByDay:     []RuleWeekday{{Ordinal: -1, Weekday: time.Saturday}},
+			},
+		},
+		{
+			name:  "every second friday with prefix and start",
+			value: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=FR;DTSTART=20261002",
+			expectedRule: &Rule{
+				Frequency: Weekly,
+				Interval:  2,
+				Start:     time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+				ByDay:     []RuleWeekday{{Weekday: time.Friday}},
+			},
+		},
+		{
+			name:  "december holidays",
+			value: "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24,25,26,-1",
+			expectedRule: &Rule{
+				Frequency:  Yearly,
+				Interval:   1,
+				Start:      defaultRuleStart,
+				ByMonth:    []time.Month{time.December},
+				ByMonthDay: []int{24, 25, 26, -1},
+			},
+		},
+		{
+			name:  "rule with end",
+			value: "FREQ=MONTHLY;BYDAY=1SA;UNTIL=20271231T235959Z;WKST=MO",
+			expectedRule: &Rule{
+				Frequency: Monthly,
+				Interval:  1,
+				Start:     defaultRuleStart,
+				Until:     time.Date(2027, 12, 31, 0, 0, 0, 0, time.UTC),
+				ByDay:     []RuleWeekday{{Ordinal: 1, Weekday: time.Saturday}},
+			},
+		},
+		{name: "missing frequency", value: "BYDAY=FR", expectError: true},
+		{name: "invalid start", value: "FREQ=MONTHLY;BYDAY=1SA;DTSTART=2026", expectError: true},
+		{name: "unsupported week start", value: "FREQ=WEEKLY;BYDAY=FR;WKST=SU", expectError: true},
+		{name: "unsupported frequency", value: "FREQ=DAILY", expectError: true},
+		{name: "unsupported part", value: "FREQ=MONTHLY;BYHOUR=20", expectError: true},
+		{name: "malformed part", value: "FREQ=MONTHLY;BYDAY", expectError: true},
+		{name: "invalid weekday", value: "FREQ=MONTHLY;BYDAY=XX", expectError: true},
+		{name: "invalid ordinal", value: "FREQ=MONTHLY;BYDAY=0FR", expectError: true},
+		{name: "invalid month day", value: "FREQ=MONTHLY;BYMONTHDAY=32", expectError: true},
+		{name: "invalid month", value: "FREQ=YEARLY;BYMONTH=-1", expectError: true},
+		{name: "invalid interval", value: "FREQ=WEEKLY;INTERVAL=0;BYDAY=FR", expectError: true},
+		{name: "weekly rule without weekdays", value: "FREQ=WEEKLY", expectError: true},
+		{name: "weekly rule with ordinal", value: "FREQ=WEEKLY;BYDAY=2FR", expectError: true},
+		{name: "monthly rule without days", value: "FREQ=MONTHLY;BYSETPOS=1", expectError: true},
+		{name: "yearly rule without restrictions", value: "FREQ=YEARLY", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			rule, err := ParseRule(parameter.value)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, rule)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expectedRule, rule)
+			}
+		})
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	parameters := []struct {
+		name          string
+		rule          string
+		firstDay      time.Time
+		lastDay       time.Time
+		expectedDates []time.Time
+	}{
+		{
+			name:     "last weekend of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=SA,SU;BYSETPOS=-2,-1",
+			firstDay: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "second friday of the month",
+			rule:     "FREQ=MONTHLY;BYDAY=2FR",
+			firstDay: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 13, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "every second friday",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR;DTSTART=20261002",
+			firstDay: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "every other month on the first saturday",
+			rule:     "FREQ=MONTHLY;INTERVAL=2;BYDAY=1SA;DTSTART=20261001",
+			firstDay: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "christmas days and new year's eve",
+			rule:     "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=24,25,26,-1",
+			firstDay: time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2027, 1, 2, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "first saturday between start and end",
+			rule:     "FREQ=MONTHLY;BYDAY=1SA;DTSTART=20261101;UNTIL=20261231",
+			firstDay: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 11, 7, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "last friday of the year",
+			rule:     "FREQ=YEARLY;BYDAY=-1FR",
+			firstDay: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			lastDay:  time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			rule, err := ParseRule(parameter.rule)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var matches []time.Time
+			for day := parameter.firstDay; !day.After(parameter.lastDay); day = day.AddDate(0, 0, 1) {
+				if rule.Matches(day) {
+					matches = append(matches, day)
+				}
+			}
+
+			assert.Equal(t, parameter.expectedDates, matches)
+		})
+	}
+}
+
+func TestRule_MatchesInLocation(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	rule, err := ParseRule("FREQ=MONTHLY;BYDAY=-1SA")
+
+	assert.NoError(t, err)
+	assert.True(t, rule.Matches(time.Date(2026, 10, 31, 20, 0, 0, 0, berlin)))
+	assert.False(t, rule.Matches(time.Date(2026, 10, 24, 20, 0, 0, 0, berlin)))
+}
@@ -0,0 +1,259 @@
+package poll
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	StrategyPlurality       = "plurality"
+	StrategyApproval        = "approval"
+	StrategyRoleWeighted    = "roleWeighted"
+	StrategyRequiredMembers = "requiredMembers"
+)
+
+type AnswerVotes struct {
+	Date  time.Time
+	Votes int
+	// user ids of the voters, nil if the voters were not fetched
+	Voters []string
+}
+
+type AnswerScore struct {
+	Date     time.Time
+	Votes    int
+	Score    float64
+	Eligible bool
+	// user ids of all voters of the answer, nil if the voters were not fetched
+	Voters []string
+}
+
+type ResultStrategy interface {
+	Score(answers []AnswerVotes) ([]AnswerScore, error)
+	RequiresVoters() bool
+}
+
+type PluralityStrategy struct{}
+
+type ApprovalStrategy struct {
+	Threshold int
+}
+
+type RoleWeightedStrategy struct {
+	Weights       map[string]float64
+	DefaultWeight float64
+	MemberRoles   map[string][]string
+}
+
+// RequiredMembersStrategy scores answers with its strategy, but only dates all members voted for are eligible
+type RequiredMembersStrategy struct {
+	Members  []string
+	Strategy ResultStrategy
+}
+
+// EligibleVotersStrategy only counts voters with one of the roles before scoring with its strategy
+type EligibleVotersStrategy struct {
+	Roles       []string
+	MemberRoles map[string][]string
+	Strategy    ResultStrategy
+}
+
+func NewResultStrategy(name string, approvalThreshold int, roleWeights map[string]float64, requiredMembers []string) (ResultStrategy, error) {
+	switch name {
+	case "", StrategyPlurality:
+		return PluralityStrategy{}, nil
+	case StrategyApproval:
+		if approvalThreshold < 1 {
+			return nil, fmt.Errorf("approval strategy requires a positive threshold")
+		}
+		return ApprovalStrategy{Threshold: approvalThreshold}, nil
+	case StrategyRoleWeighted:
+		if len(roleWeights) == 0 {
+			return nil, fmt.Errorf("role weighted strategy requires role weights")
+		}
+		return RoleWeightedStrategy{Weights: roleWeights, DefaultWeight: 1}, nil
+	case StrategyRequiredMembers:
+		if len(requiredMembers) == 0 {
+			return nil, fmt.Errorf("required members strategy requires at least one member")
+		}
+		return RequiredMembersStrategy{Members: requiredMembers, Strategy: PluralityStrategy{}}, nil
+	default:
+		return nil, fmt.Errorf("unknown result strategy: %s", name)
+	}
+}
+
+func WithRequiredMembers(strategy ResultStrategy, requiredMembers []string) ResultStrategy {
+	if len(requiredMembers) == 0 {
+		return strategy
+	}
+	if required, ok := strategy.(RequiredMembersStrategy); ok {
+		required.Members = append(slices.Clone(required.Members), requiredMembers...)
+		return required
+	}
+	return RequiredMembersStrategy{Members: requiredMembers, Strategy: strategy}
+}
+
+func WithEligibleRoles(strategy ResultStrategy, eligibleRoles []string, memberRoles map[string][]string) ResultStrategy {
+	if len(eligibleRoles) == 0 {
+		return strategy
+	}
+	return EligibleVotersStrategy{Roles: eligibleRoles, MemberRoles: memberRoles, Strategy: strategy}
+}
+
+func WithMemberRoles(strategy ResultStrategy, memberRoles map[string][]string) ResultStrategy {
+	switch s := strategy.(type) {
+	case RoleWeightedStrategy:
+		s.MemberRoles = memberRoles
+		return s
+	case EligibleVotersStrategy:
+		s.MemberRoles = memberRoles
+		s.Strategy = WithMemberRoles(s.Strategy, memberRoles)
+		return s
+	case RequiredMembersStrategy:
+		s.Strategy = WithMemberRoles(s.Strategy, memberRoles)
+		return s
+	default:
+		return strategy
+	}
+}
+
+func (PluralityStrategy) Score(answers []AnswerVotes) ([]AnswerScore, error) {
+	var scores []AnswerScore
+	for _, answer := range answers {
+		scores = append(scores, AnswerScore{Date: answer.Date, Votes: answer.Votes, Score: float64(answer.Votes), Eligible: true})
+	}
+	return scores, nil
+}
+
+func (PluralityStrategy) RequiresVoters() bool {
+	return false
+}
+
+func (a ApprovalStrategy) Score(answers []AnswerVotes) ([]AnswerScore, error) {
+	var scores []AnswerScore
+	for _, answer := range answers {
+		scores = append(scores, AnswerScore{Date: answer.Date, Votes: answer.Votes, Score: float64(answer.Votes), Eligible: answer.Votes >= a.Threshold})
+	}
+	return scores, nil
+}
+
+func (ApprovalStrategy) RequiresVoters() bool {
+	return false
+}
+
+func (r RoleWeightedStrategy) Score(answers []AnswerVotes) ([]AnswerScore, error) {
+	var scores []AnswerScore
+	for _, answer := range answers {
+		if answer.Voters == nil && answer.Votes > 0 {
+			return nil, fmt.Errorf("role weighted strategy requires the voters of each answer")
+		}
+		// without member roles every voter would silently get the default weight
+		if r.MemberRoles == nil && answer.Votes > 0 {
+			return nil, fmt.Errorf("role weighted strategy requires the roles of the guild members")
+		}
+		score := 0.0
+		for _, voter := range answer.Voters {
+			score += r.weight(voter)
+		}
+		scores = append(scores, AnswerScore{Date: answer.Date, Votes: answer.Votes, Score: score, Eligible: true})
+	}
+	return scores, nil
+}
+
+func (RoleWeightedStrategy) RequiresVoters() bool {
+	return true
+}
+
+// weight uses the highest weight of all roles of a member, members without weighted roles get the default weight
+func (r RoleWeightedStrategy) weight(userID string) float64 {
+	weight, found := 0.0, false
+	for _, role := range r.MemberRoles[userID] {
+		if roleWeight, ok := r.Weights[role]; ok && (!found || roleWeight > weight) {
+			weight, found = roleWeight, true
+		}
+	}
+	if !found {
+		return r.DefaultWeight
+	}
+	return weight
+}
+
+func (r RequiredMembersStrategy) Score(answers []AnswerVotes) ([]AnswerScore, error) {
+	strategy := r.Strategy
+	if strategy == nil {
+		strategy = PluralityStrategy{}
+	}
+	scores, err := strategy.Score(answers)
+	if err != nil {
+		return nil, err
+	}
+	for i, answer := range answers {
+		if answer.Voters == nil && answer.Votes > 0 {
+			return nil, fmt.Errorf("required members strategy requires the voters of each answer")
+		}
+		for _, member := range r.Members {
+			if !slices.Contains(answer.Voters, member) {
+				scores[i].Eligible = false
+			}
+		}
+	}
+	return scores, nil
+}
+
+func (RequiredMembersStrategy) RequiresVoters() bool {
+	return true
+}
+
+func (e EligibleVotersStrategy) Score(answers []AnswerVotes) ([]AnswerScore, error) {
+	strategy := e.Strategy
+	if strategy == nil {
+		strategy = PluralityStrategy{}
+	}
+	var eligibleAnswers []AnswerVotes
+	for _, answer := range answers {
+		if answer.Voters == nil && answer.Votes > 0 {
+			return nil, fmt.Errorf("eligible voters strategy requires the voters of each answer")
+		}
+		if e.MemberRoles == nil && answer.Votes > 0 {
+			return nil, fmt.Errorf("eligible voters strategy requires the roles of the guild members")
+		}
+		eligibleVoters := []string{}
+		for _, voter := range answer.Voters {
+			if e.isEligible(voter) {
+				eligibleVoters = append(eligibleVoters, voter)
+			}
+		}
+		eligibleAnswers = append(eligibleAnswers, AnswerVotes{Date: answer.Date, Votes: len(eligibleVoters), Voters: eligibleVoters})
+	}
+	return strategy.Score(eligibleAnswers)
+}
+
+func (EligibleVotersStrategy) RequiresVoters() bool {
+	return true
+}
+
+func (e EligibleVotersStrategy) isEligible(userID string) bool {
+	return slices.ContainsFunc(e.MemberRoles[userID], func(role string) bool {
+		return slices.Contains(e.Roles, role)
+	})
+}
+
+// SelectWinners returns all eligible answers with the highest score, answers without votes never win
+func SelectWinners(scores []AnswerScore) ([]time.Time, float64) {
+	var winners []time.Time
+	var highestScore float64
+	for _, score := range scores {
+		if !score.Eligible || score.Votes == 0 {
+			continue
+		}
+		if len(winners) == 0 || score.Score > highestScore {
+			highestScore = score.Score
+			winners = nil
+		}
+		if score.Score == highestScore {
+			winners = append(winners, score.Date)
+		}
+	}
+	return winners, highestScore
+}
@@ -0,0 +1,273 @@
+package poll
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResultStrategies(t *testing.T) {
+	friday := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC)
+	answers := []AnswerVotes{
+		{Date: friday, Votes: 3, Voters: []string{"alice", "bob", "carol"}},
+		{Date: saturday, Votes: 2, Voters: []string{"dave", "alice"}},
+	}
+	parameters := []struct {
+		name           string
+		strategy       ResultStrategy
+		expectedScores []AnswerScore
+	}{
+		{
+			name:     "plurality",
+			strategy: PluralityStrategy{},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 3, Score: 3, Eligible: true},
+				{Date: saturday, Votes: 2, Score: 2, Eligible: true},
+			},
+		},
+		{
+			name:     "approval threshold",
+			strategy: ApprovalStrategy{Threshold: 3},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 3, Score: 3, Eligible: true},
+				{Date: saturday, Votes: 2, Score: 2, Eligible: false},
+			},
+		},
+		{
+			name: "role weighted",
+			strategy: RoleWeightedStrategy{
+				Weights:       map[string]float64{"core": 2, "guest": 0.5},
+				DefaultWeight: 1,
+				MemberRoles:   map[string][]string{"alice": {"guest", "core"}, "bob": {"guest"}, "carol": {"guest"}, "dave": {"core"}},
+			},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 3, Score: 3, Eligible: true},
+				{Date: saturday, Votes: 2, Score: 4, Eligible: true},
+			},
+		},
+		{
+			name:     "required members with approval threshold",
+			strategy: RequiredMembersStrategy{Members: []string{"bob"}, Strategy: ApprovalStrategy{Threshold: 4}},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 3, Score: 3, Eligible: false},
+				{Date: saturday, Votes: 2, Score: 2, Eligible: false},
+			},
+		},
+		{
+			name:     "required members",
+			strategy: RequiredMembersStrategy{Members: []string{"alice", "bob"}},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 3, Score: 3, Eligible: true},
+				{Date: saturday, Votes: 2, Score: 2, Eligible: false},
+			},
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			scores, err := parameter.strategy.Score(answers)
+
+			assert.NoError(t, err)
+			assert.Equal(t, parameter.expectedScores, scores)
+		})
+	}
+}
+
+func TestResultStrategies_WithoutVoters(t *testing.T) {
+	answers := []AnswerVotes{{Date: time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC), Votes: 3}}
+
+	_, err := RoleWeightedStrategy{Weights: map[string]float64{"core": 2}}.Score(answers)
+	assert.Error(t, err)
+	_, err = RequiredMembersStrategy{Members: []string{"alice"}}.Score(answers)
+	assert.Error(t, err)
+	assert.False(t, PluralityStrategy{}.RequiresVoters())
+	assert.False(t, ApprovalStrategy{}.RequiresVoters())
+	assert.True(t, RoleWeightedStrategy{}.RequiresVoters())
+	assert.True(t, RequiredMembersStrategy{}.RequiresVoters())
+}
+
+func TestResultStrategies_WithoutMemberRoles(t *testing.T) {
+	answers := []AnswerVotes{{Date: time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC), Votes: 1, Voters: []string{"alice"}}}
+
+	_, err := RoleWeightedStrategy{Weights: map[string]float64{"core": 2}, DefaultWeight: 1}.Score(answers)
+	assert.Error(t, err)
+	_, err = EligibleVotersStrategy{Roles: []string{"core"}}.Score(answers)
+	assert.Error(t, err)
+	_, err = RoleWeightedStrategy{Weights: map[string]float64{"core": 2}, DefaultWeight: 1, MemberRoles: map[string][]string{}}.Score(answers)
+	assert.NoError(t, err)
+}
+
+func TestNewResultStrategy(t *testing.T) {
+	parameters := []struct {
+		name              string
+		strategy          string
+		approvalThreshold int
+		roleWeights       map[string]float64
+		requiredMembers   []string
+		expected          ResultStrategy
+		expectError       bool
+	}{
+		{name: "default", expected: PluralityStrategy{}},
+		{name: "plurality", strategy: "plurality", expected: PluralityStrategy{}},
+		{name: "approval", strategy: "approval", approvalThreshold: 3, expected: ApprovalStrategy{Threshold: 3}},
+		{name: "role weighted", strategy: "roleWeighted", roleWeights: map[string]float64{"core": 2}, expected: RoleWeightedStrategy{Weights: map[string]float64{"core": 2}, DefaultWeight: 1}},
+		{name: "required members", strategy: "requiredMembers", requiredMembers: []string{"alice"}, expected: RequiredMembersStrategy{Members: []string{"alice"}, Strategy: PluralityStrategy{}}},
+		{name: "approval without threshold", strategy: "approval", expectError: true},
+		{name: "role weighted without weights", strategy: "roleWeighted", expectError: true},
+		{name: "required members without members", strategy: "requiredMembers", expectError: true},
+		{name: "unknown", strategy: "condorcet", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			strategy, err := NewResultStrategy(parameter.strategy, parameter.approvalThreshold, parameter.roleWeights, parameter.requiredMembers)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expected, strategy)
+			}
+		})
+	}
+}
+
+func TestSelectWinners(t *testing.T) {
+	first := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 10, 10, 20, 0, 0, 0, time.UTC)
+	third := time.Date(2026, 10, 16, 20, 0, 0, 0, time.UTC)
+	parameters := []struct {
+		name            string
+		scores          []AnswerScore
+		expectedWinners []time.Time
+		expectedScore   float64
+	}{
+		{
+			name: "single winner",
+			scores: []AnswerScore{
+				{Date: first, Votes: 2, Score: 2, Eligible: true},
+				{Date: second, Votes: 4, Score: 4, Eligible: true},
+			},
+			expectedWinners: []time.Time{second},
+			expectedScore:   4,
+		},
+		{
+			name: "tie keeps answer order",
+			scores: []AnswerScore{
+				{Date: first, Votes: 4, Score: 4, Eligible: true},
+				{Date: second, Votes: 1, Score: 1, Eligible: true},
+				{Date: third, Votes: 4, Score: 4, Eligible: true},
+			},
+			expectedWinners: []time.Time{first, third},
+			expectedScore:   4,
+		},
+		{
+			name: "ineligible answers are skipped",
+			scores: []AnswerScore{
+				{Date: first, Votes: 5, Score: 5, Eligible: false},
+				{Date: second, Votes: 1, Score: 1, Eligible: true},
+			},
+			expectedWinners: []time.Time{second},
+			expectedScore:   1,
+		},
+		{
+			name: "answers without votes never win",
+			scores: []AnswerScore{
+				{Date: first, Votes: 0, Score: 0, Eligible: true},
+			},
+		},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			winners, score := SelectWinners(parameter.scores)
+
+			assert.Equal(t, parameter.expectedWinners, winners)
+			assert.Equal(t, parameter.expectedScore, score)
+		})
+	}
+}
+
+func TestWithRequiredMembers(t *testing.T) {
+	assert.Equal(t, PluralityStrategy{}, WithRequiredMembers(PluralityStrategy{}, nil))
+	assert.Equal(t, RequiredMembersStrategy{Members: []string{"alice"}, Strategy: ApprovalStrategy{Threshold: 2}}, WithRequiredMembers(ApprovalStrategy{Threshold: 2}, []string{"alice"}))
+	assert.Equal(t, RequiredMembersStrategy{Members: []string{"alice", "bob"}}, WithRequiredMembers(RequiredMembersStrategy{Members: []string{"alice"}}, []string{"bob"}))
+}
+
+func TestEligibleVotersStrategy_Score(t *testing.T) {
+	friday := time.Date(2025, time.November, 7, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2025, time.November, 8, 20, 0, 0, 0, time.UTC)
+	memberRoles := map[string][]string{"alice": {"core"}, "bob": {"core", "member"}, "carol": {"guest"}}
+	answers := []AnswerVotes{
+		{Date: friday, Votes: 3, Voters: []string{"alice", "carol", "dave"}},
+		{Date: saturday, Votes: 2, Voters: []string{"alice", "bob"}},
+	}
+	parameters := []struct {
+		name           string
+		strategy       EligibleVotersStrategy
+		expectedScores []AnswerScore
+	}{
+		{
+			name:     "plurality of eligible voters",
+			strategy: EligibleVotersStrategy{Roles: []string{"core"}, MemberRoles: memberRoles},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 1, Score: 1, Eligible: true},
+				{Date: saturday, Votes: 2, Score: 2, Eligible: true},
+			},
+		},
+		{
+			name: "role weights of eligible voters",
+			strategy: EligibleVotersStrategy{
+				Roles:       []string{"core", "guest"},
+				MemberRoles: memberRoles,
+				Strategy:    RoleWeightedStrategy{Weights: map[string]float64{"guest": 0.5, "member": 2}, DefaultWeight: 1, MemberRoles: memberRoles},
+			},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 2, Score: 1.5, Eligible: true},
+				{Date: saturday, Votes: 2, Score: 3, Eligible: true},
+			},
+		},
+		{
+			name:     "no eligible voters",
+			strategy: EligibleVotersStrategy{Roles: []string{"admin"}, MemberRoles: memberRoles},
+			expectedScores: []AnswerScore{
+				{Date: friday, Votes: 0, Score: 0, Eligible: true},
+				{Date: saturday, Votes: 0, Score: 0, Eligible: true},
+			},
+		},
+	}
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			scores, err := parameter.strategy.Score(answers)
+
+			assert.NoError(t, err)
+			assert.Equal(t, parameter.expectedScores, scores)
+			assert.True(t, parameter.strategy.RequiresVoters())
+		})
+	}
+
+	t.Run("error without voters", func(t *testing.T) {
+		_, err := EligibleVotersStrategy{Roles: []string{"core"}}.Score([]AnswerVotes{{Date: friday, Votes: 1}})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestWithEligibleRoles(t *testing.T) {
+	memberRoles := map[string][]string{"alice": {"core"}}
+
+	assert.Equal(t, PluralityStrategy{}, WithEligibleRoles(PluralityStrategy{}, nil, memberRoles))
+	assert.Equal(t, EligibleVotersStrategy{Roles: []string{"core"}, MemberRoles: memberRoles, Strategy: PluralityStrategy{}}, WithEligibleRoles(PluralityStrategy{}, []string{"core"}, memberRoles))
+}
+
+func TestWithMemberRoles(t *testing.T) {
+	memberRoles := map[string][]string{"alice": {"core"}}
+
+	assert.Equal(t, PluralityStrategy{}, WithMemberRoles(PluralityStrategy{}, memberRoles))
+	assert.Equal(t, RoleWeightedStrategy{MemberRoles: memberRoles}, WithMemberRoles(RoleWeightedStrategy{}, memberRoles))
+	assert.Equal(t,
+		RequiredMembersStrategy{Strategy: EligibleVotersStrategy{MemberRoles: memberRoles, Strategy: RoleWeightedStrategy{MemberRoles: memberRoles}}},
+		WithMemberRoles(RequiredMembersStrategy{Strategy: EligibleVotersStrategy{Strategy: RoleWeightedStrategy{}}}, memberRoles))
+}
@@ -0,0 +1,177 @@
+package poll
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	TieBreakEarliest        = "earliest"
+	TieBreakLatest          = "latest"
+	TieBreakWeekday         = "weekday"
+	TieBreakFewestConflicts = "fewestConflicts"
+	TieBreakRandom          = "random"
+)
+
+type TieBreaker interface {
+	Break(tied []time.Time) time.Time
+	Description() string
+}
+
+type EarliestTieBreaker struct{}
+
+type LatestTieBreaker struct{}
+
+type WeekdayTieBreaker struct {
+	Order []time.Weekday
+}
+
+type FewestConflictsTieBreaker struct {
+	Conflicts []DateMatcher
+}
+
+type RandomTieBreaker struct {
+	Seed string
+}
+
+type TieBreak struct {
+	Winner      time.Time
+	Others      []time.Time
+	Description string
+}
+
+func NewTieBreak(tieBreaker TieBreaker, tied []time.Time) *TieBreak {
+	if len(tied) == 0 {
+		return &TieBreak{Description: tieBreaker.Description()}
+	}
+	winner := tieBreaker.Break(tied)
+	var others []time.Time
+	for _, date := range sortedDates(tied) {
+		if !date.Equal(winner) {
+			others = append(others, date)
+		}
+	}
+	return &TieBreak{
+		Winner:      winner,
+		Others:      others,
+		Description: tieBreaker.Description(),
+	}
+}
+
+func (t *TieBreak) IsTie() bool {
+	return len(t.Others) > 0
+}
+
+func (EarliestTieBreaker) Break(tied []time.Time) time.Time {
+	return sortedDates(tied)[0]
+}
+
+func (EarliestTieBreaker) Description() string {
+	return "earliest date"
+}
+
+func (LatestTieBreaker) Break(tied []time.Time) time.Time {
+	sorted := sortedDates(tied)
+	return sorted[len(sorted)-1]
+}
+
+func (LatestTieBreaker) Description() string {
+	return "latest date"
+}
+
+func (w WeekdayTieBreaker) Break(tied []time.Time) time.Time {
+	return minBy(sortedDates(tied), func(date time.Time) int {
+		if index := slices.Index(w.Order, date.Weekday()); index >= 0 {
+			return index
+		}
+		return len(w.Order)
+	})
+}
+
+func (w WeekdayTieBreaker) Description() string {
+	var names []string
+	for _, weekday := range w.Order {
+		names = append(names, weekday.String())
+	}
+	return fmt.Sprintf("weekday preference (%s)", strings.Join(names, ", "))
+}
+
+func (f FewestConflictsTieBreaker) Break(tied []time.Time) time.Time {
+	return minBy(sortedDates(tied), func(date time.Time) int {
+		conflicts := 0
+		for _, matcher := range f.Conflicts {
+			if matcher.Matches(date) {
+				conflicts++
+			}
+		}
+		return conflicts
+	})
+}
+
+func (FewestConflictsTieBreaker) Description() string {
+	return "fewest conflicts"
+}
+
+// Break picks the tied date at index FNV-1a(seed) mod n from the dates sorted in ascending order,
+// so that anyone who knows the seed can repeat the draw
+func (r RandomTieBreaker) Break(tied []time.Time) time.Time {
+	sorted := sortedDates(tied)
+	return sorted[r.draw(len(sorted))]
+}
+
+func (r RandomTieBreaker) Description() string {
+	return fmt.Sprintf("random draw (FNV-1a of seed '%s' modulo number of tied dates)", r.Seed)
+}
+
+func (r RandomTieBreaker) draw(n int) int {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(r.Seed))
+	return int(hash.Sum64() % uint64(n))
+}
+
+func NewTieBreaker(strategy string, weekdayOrder []time.Weekday, conflicts []DateMatcher, seed string) (TieBreaker, error) {
+	switch strategy {
+	case "", TieBreakEarliest:
+		return EarliestTieBreaker{}, nil
+	case TieBreakLatest:
+		return LatestTieBreaker{}, nil
+	case TieBreakWeekday:
+		if len(weekdayOrder) == 0 {
+			return nil, fmt.Errorf("weekday tie break requires a weekday order")
+		}
+		return WeekdayTieBreaker{Order: weekdayOrder}, nil
+	case TieBreakFewestConflicts:
+		return FewestConflictsTieBreaker{Conflicts: conflicts}, nil
+	case TieBreakRandom:
+		if seed == "" {
+			return nil, fmt.Errorf("random tie break requires a seed")
+		}
+		return RandomTieBreaker{Seed: seed}, nil
+	default:
+		return nil, fmt.Errorf("unknown tie break strategy: %s", strategy)
+	}
+}
+
+func sortedDates(dates []time.Time) []time.Time {
+	sorted := slices.Clone(dates)
+	slices.SortFunc(sorted, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+	return sorted
+}
+
+// minBy returns the first date with the lowest score
+func minBy(dates []time.Time, score func(date time.Time) int) time.Time {
+	best := dates[0]
+	bestScore := score(best)
+	for _, date := range dates[1:] {
+		if dateScore := score(date); dateScore < bestScore {
+			best = date
+			bestScore = dateScore
+		}
+	}
+	return best
+}
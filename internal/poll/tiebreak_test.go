@@ -0,0 +1,91 @@
+package poll
+
+import (
+	"hash/fnv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTieBreakers(t *testing.T) {
+	friday := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+	saturday := time.Date(2026, 10, 3, 20, 0, 0, 0, time.UTC)
+	sunday := time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC)
+	holidays, _ := NewHolidayCalendar("DE", "")
+	weekends, _ := ParseRule("FREQ=WEEKLY;BYDAY=SA,SU")
+	tied := []time.Time{friday, saturday, sunday}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte("poll-1,poll-2"))
+	randomWinner := []time.Time{saturday, friday, sunday}[hash.Sum64()%3]
+
+	parameters := []struct {
+		name           string
+		tieBreaker     TieBreaker
+		expectedWinner time.Time
+	}{
+		{name: "earliest", tieBreaker: EarliestTieBreaker{}, expectedWinner: saturday},
+		{name: "latest", tieBreaker: LatestTieBreaker{}, expectedWinner: sunday},
+		{name: "weekday order", tieBreaker: WeekdayTieBreaker{Order: []time.Weekday{time.Sunday, time.Friday}}, expectedWinner: sunday},
+		{name: "weekday order without preferred weekday", tieBreaker: WeekdayTieBreaker{Order: []time.Weekday{time.Monday}}, expectedWinner: saturday},
+		{name: "fewest conflicts", tieBreaker: FewestConflictsTieBreaker{Conflicts: []DateMatcher{holidays, weekends}}, expectedWinner: friday},
+		{name: "fewest conflicts without conflicts", tieBreaker: FewestConflictsTieBreaker{}, expectedWinner: saturday},
+		{name: "seeded random", tieBreaker: RandomTieBreaker{Seed: "poll-1,poll-2"}, expectedWinner: randomWinner},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			tieBreak := NewTieBreak(parameter.tieBreaker, tied)
+
+			assert.Equal(t, parameter.expectedWinner, tieBreak.Winner)
+			assert.True(t, tieBreak.IsTie())
+			assert.Len(t, tieBreak.Others, 2)
+			assert.NotContains(t, tieBreak.Others, parameter.expectedWinner)
+			assert.Equal(t, parameter.tieBreaker.Description(), tieBreak.Description)
+		})
+	}
+}
+
+func TestNewTieBreak_SingleWinner(t *testing.T) {
+	winner := time.Date(2026, 10, 9, 20, 0, 0, 0, time.UTC)
+
+	tieBreak := NewTieBreak(LatestTieBreaker{}, []time.Time{winner})
+
+	assert.Equal(t, winner, tieBreak.Winner)
+	assert.False(t, tieBreak.IsTie())
+}
+
+func TestNewTieBreaker(t *testing.T) {
+	parameters := []struct {
+		name         string
+		strategy     string
+		weekdayOrder []time.Weekday
+		seed         string
+		expected     TieBreaker
+		expectError  bool
+	}{
+		{name: "default", expected: EarliestTieBreaker{}},
+		{name: "earliest", strategy: "earliest", expected: EarliestTieBreaker{}},
+		{name: "latest", strategy: "latest", expected: LatestTieBreaker{}},
+		{name: "weekday", strategy: "weekday", weekdayOrder: []time.Weekday{time.Saturday}, expected: WeekdayTieBreaker{Order: []time.Weekday{time.Saturday}}},
+		{name: "fewest conflicts", strategy: "fewestConflicts", expected: FewestConflictsTieBreaker{}},
+		{name: "random", strategy: "random", seed: "seed", expected: RandomTieBreaker{Seed: "seed"}},
+		{name: "weekday without order", strategy: "weekday", expectError: true},
+		{name: "random without seed", strategy: "random", expectError: true},
+		{name: "unknown", strategy: "coinFlip", expectError: true},
+	}
+
+	for _, parameter := range parameters {
+		t.Run(parameter.name, func(t *testing.T) {
+			tieBreaker, err := NewTieBreaker(parameter.strategy, parameter.weekdayOrder, nil, parameter.seed)
+
+			if parameter.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, parameter.expected, tieBreaker)
+			}
+		})
+	}
+}
@@ -23,12 +23,17 @@ variable "start_poll_message" {
 
 variable "start_poll_schedule_expression" {
   type    = string
-  default = "cron(0 20 15 1,2,3,4,5,6,7,8,9,10,12 ? *)"
+  default = "cron(0 20 15 1,2,3,4,5,6,7,8,9,10,11,12 ? *)"
 }
 
-variable "start_poll_schedule_expression_december" {
-  type    = string
-  default = "cron(0 20 15 11 ? *)"
+variable "start_poll_include_rules" {
+  type    = list(string)
+  default = ["FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=26,27,28,29,30"]
+}
+
+variable "start_poll_exclude_rules" {
+  type    = list(string)
+  default = ["FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=23,24,25,31"]
 }
 
 variable "start_poll_schedule_flexible_time_window_mode" {
@@ -46,11 +51,6 @@ variable "start_poll_schedule_name" {
   default = "discord-date-decider-start-poll"
 }
 
-variable "start_poll_schedule_name_december" {
-  type    = string
-  default = "discord-date-decider-start-poll-december"
-}
-
 variable "start_poll_title" {
   type    = string
   default = "WAN-Party %s %d"